@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import (
+	"strings"
+	"sync"
+)
+
+// Builder builds a string grapheme cluster by grapheme cluster, keeping
+// a running cluster count and display width so callers building a
+// status-line or table cell don't need to re-scan the whole buffer after
+// each append. The zero value is ready to use.
+type Builder struct {
+	clusters []string
+	widths   []int
+	width    int
+}
+
+// WriteGrapheme appends a single grapheme cluster to the builder.
+func (b *Builder) WriteGrapheme(g string) (int, error) {
+	w := Width(g)
+	b.clusters = append(b.clusters, g)
+	b.widths = append(b.widths, w)
+	b.width += w
+	return len(g), nil
+}
+
+// WriteString appends s to the builder, one grapheme cluster at a time.
+func (b *Builder) WriteString(s string) (int, error) {
+	n := 0
+	it := Graphemes(s)
+	for {
+		g := it.Next()
+		if g == "" {
+			break
+		}
+		written, _ := b.WriteGrapheme(g)
+		n += written
+	}
+	return n, nil
+}
+
+// Len returns the number of grapheme clusters written so far.
+func (b *Builder) Len() int {
+	return len(b.clusters)
+}
+
+// Width returns the running display width, in terminal columns, of the
+// clusters written so far.
+func (b *Builder) Width() int {
+	return b.width
+}
+
+// TruncateToWidth drops trailing grapheme clusters until the builder's
+// display width is at most w. Because whole clusters are dropped, this
+// never leaves a dangling ZWJ or half of a flag pair behind.
+func (b *Builder) TruncateToWidth(w int) {
+	for len(b.clusters) > 0 && b.width > w {
+		last := len(b.clusters) - 1
+		b.width -= b.widths[last]
+		b.clusters = b.clusters[:last]
+		b.widths = b.widths[:last]
+	}
+}
+
+// String returns the accumulated string.
+func (b *Builder) String() string {
+	var sb strings.Builder
+	for _, c := range b.clusters {
+		sb.WriteString(c)
+	}
+	return sb.String()
+}
+
+// Reset resets the builder to be empty.
+func (b *Builder) Reset() {
+	b.clusters = b.clusters[:0]
+	b.widths = b.widths[:0]
+	b.width = 0
+}
+
+// WriteSub appends the substring of s from start grapheme for count
+// graphemes, as Sub(s, start, count) would return.
+func (b *Builder) WriteSub(s string, start, count int) (int, error) {
+	return b.WriteString(Sub(s, start, count))
+}
+
+// WritePadLeft appends s padded on the left to width columns with pad,
+// as PadLeft(s, width, pad) would return.
+func (b *Builder) WritePadLeft(s string, width int, pad string) (int, error) {
+	return b.WriteString(PadLeft(s, width, pad))
+}
+
+// WriteTruncate appends s truncated to fit within maxWidth columns, as
+// Truncate(s, maxWidth) would return.
+func (b *Builder) WriteTruncate(s string, maxWidth int) (int, error) {
+	return b.WriteString(Truncate(s, maxWidth))
+}
+
+// builderPool recycles Builders so the common "build a padded/truncated
+// line" pattern in a hot loop doesn't allocate a new Builder (or grow
+// its backing slices from scratch) on every call once the pool has
+// warmed up.
+var builderPool = sync.Pool{
+	New: func() any { return new(Builder) },
+}
+
+// GetBuilder returns a Builder from a package-wide pool, already Reset
+// and ready to use. Pair every GetBuilder with a PutBuilder once the
+// built string has been consumed.
+func GetBuilder() *Builder {
+	return builderPool.Get().(*Builder)
+}
+
+// PutBuilder resets b and returns it to the package-wide pool for reuse
+// by a future GetBuilder call. Don't use b after calling PutBuilder.
+func PutBuilder(b *Builder) {
+	b.Reset()
+	builderPool.Put(b)
+}
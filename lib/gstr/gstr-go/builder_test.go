@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import "testing"
+
+func TestBuilderWriteHelpers(t *testing.T) {
+	var b Builder
+	b.WriteSub("hello world", 0, 5)
+	b.WriteString(" | ")
+	b.WritePadLeft("x", 3, "-")
+	if got, want := b.String(), "hello | --x"; got != want {
+		t.Errorf("Builder combined writes = %q, want %q", got, want)
+	}
+}
+
+func TestBuilderPool(t *testing.T) {
+	b := GetBuilder()
+	b.WriteString("leftover")
+	PutBuilder(b)
+
+	b2 := GetBuilder()
+	if b2.Len() != 0 {
+		t.Errorf("Builder from pool Len() = %d, want 0 after PutBuilder reset it", b2.Len())
+	}
+	PutBuilder(b2)
+}
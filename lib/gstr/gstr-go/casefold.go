@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import (
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+)
+
+// CaseFold returns the full Unicode case-fold (the "C+F", common plus
+// full, mapping from CaseFolding.txt) of s. Full case folding can map
+// one rune to several - "ß" folds to "ss" and the "ﬁ" ligature folds to
+// "fi" - so CaseFold(a) == CaseFold(b) also matches those cases. EqualFold
+// and FoldCompare are built on CaseFold for exactly this reason.
+func CaseFold(s string) string {
+	return cases.Fold().String(s)
+}
+
+// EqualCaseFold is equivalent to EqualFold; it is kept as a separate name
+// for callers who want to be explicit that they're relying on full (as
+// opposed to simple, rune-by-rune) case folding, such as "weiß" == "WEISS".
+func EqualCaseFold(a, b string) bool {
+	return CaseFold(a) == CaseFold(b)
+}
+
+// IndexCaseFold returns the byte index in s of the first substring that
+// case-folds to the same string as needle under full Unicode case
+// folding, or -1 if there is none. Unlike IndexFoldASCII, a match is allowed
+// to span a different number of bytes than needle - for example needle
+// "ss" matches a "ß" in s, and needle "fi" matches the "ﬁ" ligature.
+func IndexCaseFold(s, needle string) int {
+	foldedNeedle := CaseFold(needle)
+	if foldedNeedle == "" {
+		return 0
+	}
+
+	type offsetRune struct {
+		offset int
+		r      rune
+	}
+	offs := make([]offsetRune, 0, len(s))
+	for i, r := range s {
+		offs = append(offs, offsetRune{i, r})
+	}
+
+	// A single rune's full case fold never expands to more than a
+	// handful of runes, so needle can't match a window much longer than
+	// itself; this bounds the inner scan instead of growing candidates
+	// all the way to len(s).
+	maxRunes := utf8.RuneCountInString(needle)*3 + 1
+
+	for i := range offs {
+		for j := i; j < len(offs) && j-i < maxRunes; j++ {
+			end := len(s)
+			if j+1 < len(offs) {
+				end = offs[j+1].offset
+			}
+			folded := CaseFold(s[offs[i].offset:end])
+			if folded == foldedNeedle {
+				return offs[i].offset
+			}
+			if len(folded) > len(foldedNeedle) {
+				break
+			}
+		}
+	}
+	return -1
+}
+
+// ContainsCaseFold reports whether s contains needle under full Unicode
+// case folding, including one-to-many folds (see IndexCaseFold).
+func ContainsCaseFold(s, needle string) bool {
+	return IndexCaseFold(s, needle) >= 0
+}
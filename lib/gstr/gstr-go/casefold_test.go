@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import "testing"
+
+func TestCaseFold(t *testing.T) {
+	if got := CaseFold("STRASSE"); got != "strasse" {
+		t.Errorf("CaseFold(STRASSE) = %q, want strasse", got)
+	}
+	if got := CaseFold("straße"); got != "strasse" {
+		t.Errorf("CaseFold(straße) = %q, want strasse", got)
+	}
+	if got := CaseFold("ﬁle"); got != "file" {
+		t.Errorf("CaseFold(ﬁle) = %q, want file", got)
+	}
+}
+
+func TestEqualCaseFold(t *testing.T) {
+	if !EqualCaseFold("weiß", "WEISS") {
+		t.Error("EqualCaseFold should match weiß against WEISS")
+	}
+	if EqualCaseFold("weiß", "WEIS") {
+		t.Error("weiß should not EqualCaseFold against WEIS")
+	}
+}
+
+func TestIndexCaseFold(t *testing.T) {
+	tests := []struct {
+		name   string
+		s, sub string
+		want   int
+	}{
+		{"ascii", "hello world", "WORLD", 6},
+		{"ss matches sharp s", "der straße name", "STRASSE", 4},
+		{"ligature matches fi", "a ﬁle here", "fi", 2},
+		{"not found", "hello", "xyz", -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := IndexCaseFold(tt.s, tt.sub)
+			if got != tt.want {
+				t.Errorf("IndexCaseFold(%q, %q) = %d, want %d", tt.s, tt.sub, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContainsCaseFold(t *testing.T) {
+	if !ContainsCaseFold("say WEISS now", "weiß") {
+		t.Error("ContainsCaseFold should match WEISS against weiß")
+	}
+	if ContainsCaseFold("hello", "xyz") {
+		t.Error("ContainsCaseFold should not match unrelated text")
+	}
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+// Cursor is a bidirectional grapheme-cluster cursor over a string,
+// backed by a prebuilt GraphemeIndex. Unlike GraphemeIterator, which only
+// moves forward and tracks no position, Cursor can move in either
+// direction and report its position or seek to a grapheme index in O(1)
+// (or O(K) for a cursor built on a sparse GraphemeIndex); SeekByte adds
+// a binary search over the index's stored offsets.
+type Cursor struct {
+	ix  *GraphemeIndex
+	pos int // grapheme index of the cluster Next would return
+}
+
+// NewCursor returns a Cursor over s, backed by a dense GraphemeIndex,
+// positioned before the first grapheme cluster.
+func NewCursor(s string) *Cursor {
+	return &Cursor{ix: NewIndex(s)}
+}
+
+// NewCursorFromIndex returns a Cursor backed by an already-built
+// GraphemeIndex, positioned before the first grapheme cluster. Use this
+// with a sparse GraphemeIndex to bound the memory of cursors over very
+// long strings.
+func NewCursorFromIndex(ix *GraphemeIndex) *Cursor {
+	return &Cursor{ix: ix}
+}
+
+// Next returns the grapheme cluster at the cursor and advances the
+// cursor by one. Returns "" without moving if the cursor is already at
+// the end.
+func (c *Cursor) Next() string {
+	if c.pos >= c.ix.count {
+		return ""
+	}
+	g := c.ix.At(c.pos)
+	c.pos++
+	return g
+}
+
+// Prev moves the cursor back by one and returns the grapheme cluster it
+// lands on. Returns "" without moving if the cursor is already at the
+// start.
+func (c *Cursor) Prev() string {
+	if c.pos <= 0 {
+		return ""
+	}
+	c.pos--
+	return c.ix.At(c.pos)
+}
+
+// Peek returns the grapheme cluster at the cursor without moving it.
+// Returns "" if the cursor is at the end.
+func (c *Cursor) Peek() string {
+	return c.ix.At(c.pos)
+}
+
+// SeekGrapheme moves the cursor to grapheme index n, clamped to
+// [0, Len(s)].
+func (c *Cursor) SeekGrapheme(n int) {
+	if n < 0 {
+		n = 0
+	}
+	if n > c.ix.count {
+		n = c.ix.count
+	}
+	c.pos = n
+}
+
+// SeekByte moves the cursor to the grapheme cluster containing byte
+// offset off - the nearest grapheme boundary at or before off.
+func (c *Cursor) SeekByte(off int) {
+	c.pos = c.ix.graphemeIndexAtByte(off)
+}
+
+// GraphemeIndex returns the cursor's current grapheme index.
+func (c *Cursor) GraphemeIndex() int {
+	return c.pos
+}
+
+// ByteOffset returns the byte offset of the cursor's current position.
+func (c *Cursor) ByteOffset() int {
+	return c.ix.Offset(c.pos)
+}
+
+// Slice returns the substring spanning grapheme clusters [startG, endG),
+// without moving the cursor.
+func (c *Cursor) Slice(startG, endG int) string {
+	return c.ix.Slice(startG, endG)
+}
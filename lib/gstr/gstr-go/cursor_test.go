@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import "testing"
+
+func TestCursorNextPrev(t *testing.T) {
+	s := "a👨‍👩‍👧b"
+	c := NewCursor(s)
+
+	var forward []string
+	for {
+		g := c.Next()
+		if g == "" {
+			break
+		}
+		forward = append(forward, g)
+	}
+	want := []string{"a", "👨‍👩‍👧", "b"}
+	if len(forward) != len(want) {
+		t.Fatalf("Next() sequence = %q, want %q", forward, want)
+	}
+	for i := range want {
+		if forward[i] != want[i] {
+			t.Errorf("Next() sequence = %q, want %q", forward, want)
+			break
+		}
+	}
+	if got := c.Next(); got != "" {
+		t.Errorf("Next() past the end = %q, want empty", got)
+	}
+
+	var backward []string
+	for {
+		g := c.Prev()
+		if g == "" {
+			break
+		}
+		backward = append(backward, g)
+	}
+	for i, j := 0, len(backward)-1; i < j; i, j = i+1, j-1 {
+		backward[i], backward[j] = backward[j], backward[i]
+	}
+	if len(backward) != len(want) {
+		t.Fatalf("Prev() sequence = %q, want %q", backward, want)
+	}
+	for i := range want {
+		if backward[i] != want[i] {
+			t.Errorf("Prev() sequence = %q, want %q", backward, want)
+			break
+		}
+	}
+	if got := c.Prev(); got != "" {
+		t.Errorf("Prev() past the start = %q, want empty", got)
+	}
+}
+
+func TestCursorPeekDoesNotMove(t *testing.T) {
+	c := NewCursor("abc")
+	if got := c.Peek(); got != "a" {
+		t.Fatalf("Peek() = %q, want a", got)
+	}
+	if got := c.Peek(); got != "a" {
+		t.Errorf("second Peek() = %q, want a (Peek must not move the cursor)", got)
+	}
+	if got := c.GraphemeIndex(); got != 0 {
+		t.Errorf("GraphemeIndex() after Peek = %d, want 0", got)
+	}
+}
+
+func TestCursorSeekGrapheme(t *testing.T) {
+	c := NewCursor("hello")
+	c.SeekGrapheme(2)
+	if got := c.GraphemeIndex(); got != 2 {
+		t.Fatalf("GraphemeIndex() after SeekGrapheme(2) = %d, want 2", got)
+	}
+	if got := c.Next(); got != "l" {
+		t.Errorf("Next() after SeekGrapheme(2) = %q, want l", got)
+	}
+
+	c.SeekGrapheme(-5)
+	if got := c.GraphemeIndex(); got != 0 {
+		t.Errorf("SeekGrapheme(-5) clamps to %d, want 0", got)
+	}
+
+	c.SeekGrapheme(100)
+	if got := c.GraphemeIndex(); got != 5 {
+		t.Errorf("SeekGrapheme(100) clamps to %d, want 5", got)
+	}
+}
+
+func TestCursorSeekByte(t *testing.T) {
+	s := "a👨‍👩‍👧b"
+	c := NewCursor(s)
+	ix := NewIndex(s)
+
+	c.SeekByte(ix.Offset(1))
+	if got := c.GraphemeIndex(); got != 1 {
+		t.Fatalf("SeekByte(Offset(1)) = grapheme %d, want 1", got)
+	}
+	if got := c.ByteOffset(); got != ix.Offset(1) {
+		t.Errorf("ByteOffset() after SeekByte = %d, want %d", got, ix.Offset(1))
+	}
+
+	c.SeekByte(ix.Offset(1) + 1)
+	if got := c.GraphemeIndex(); got != 1 {
+		t.Errorf("SeekByte mid-cluster = grapheme %d, want 1 (nearest boundary at or before)", got)
+	}
+}
+
+func TestCursorSlice(t *testing.T) {
+	s := "hello world"
+	c := NewCursor(s)
+	c.SeekGrapheme(6)
+	if got, want := c.Slice(0, 5), "hello"; got != want {
+		t.Errorf("Slice(0, 5) = %q, want %q", got, want)
+	}
+	if got := c.GraphemeIndex(); got != 6 {
+		t.Errorf("Slice should not move the cursor, GraphemeIndex() = %d, want 6", got)
+	}
+}
+
+func TestNewCursorFromIndex(t *testing.T) {
+	s := "the quick 🦊 fox"
+	sparse := NewSparseIndex(s, 3)
+	c := NewCursorFromIndex(sparse)
+	if got, want := c.Next(), "t"; got != want {
+		t.Errorf("Next() on sparse-backed cursor = %q, want %q", got, want)
+	}
+}
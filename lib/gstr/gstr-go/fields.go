@@ -0,0 +1,87 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// isSpaceGrapheme reports whether g is a whitespace grapheme cluster, as
+// judged by unicode.IsSpace on the cluster's first rune.
+func isSpaceGrapheme(g string) bool {
+	r, _ := utf8.DecodeRuneInString(g)
+	return unicode.IsSpace(r)
+}
+
+// Fields splits s around runs of whitespace grapheme clusters, returning
+// the non-empty fields between them. Whitespace is recognized by
+// unicode.IsSpace applied to each cluster's first rune, so U+00A0 NBSP,
+// U+3000 ideographic space, and similar are treated as separators just
+// like ASCII space, tab, and newline.
+func Fields(s string) []string {
+	return FieldsFunc(s, isSpaceGrapheme)
+}
+
+// FieldsFunc splits s at each run of grapheme clusters for which f
+// returns true, invoking f once per cluster (rather than once per rune,
+// as strings.FieldsFunc does), and returns the non-empty fields between
+// the runs.
+func FieldsFunc(s string, f func(g string) bool) []string {
+	var out []string
+	var start = -1
+	pos := 0
+	it := Graphemes(s)
+	for {
+		g := it.Next()
+		if g == "" {
+			break
+		}
+		if f(g) {
+			if start >= 0 {
+				out = append(out, s[start:pos])
+				start = -1
+			}
+		} else if start < 0 {
+			start = pos
+		}
+		pos += len(g)
+	}
+	if start >= 0 {
+		out = append(out, s[start:pos])
+	}
+	return out
+}
+
+// TrimFunc returns s with leading and trailing grapheme clusters
+// satisfying f removed.
+func TrimFunc(s string, f func(g string) bool) string {
+	return TrimRightFunc(TrimLeftFunc(s, f), f)
+}
+
+// TrimLeftFunc returns s with leading grapheme clusters satisfying f
+// removed.
+func TrimLeftFunc(s string, f func(g string) bool) string {
+	it := Graphemes(s)
+	pos := 0
+	for {
+		g := it.Next()
+		if g == "" || !f(g) {
+			break
+		}
+		pos += len(g)
+	}
+	return s[pos:]
+}
+
+// TrimRightFunc returns s with trailing grapheme clusters satisfying f
+// removed.
+func TrimRightFunc(s string, f func(g string) bool) string {
+	offs := graphemeBoundaries(s)
+	end := len(offs) - 1
+	for end > 0 && f(s[offs[end-1]:offs[end]]) {
+		end--
+	}
+	return s[:offs[end]]
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFields(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []string
+	}{
+		{"ascii", "  foo  bar  ", []string{"foo", "bar"}},
+		{"tabs and newlines", mixedWS + "world", []string{"hello", "world"}},
+		{"nbsp", "foo bar", []string{"foo", "bar"}},
+		{"ideographic space", "foo　bar", []string{"foo", "bar"}},
+		{"zwj cluster is one field", "👨‍👩‍👧 👋", []string{"👨‍👩‍👧", "👋"}},
+		{"empty", "", nil},
+		{"all whitespace", "   ", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Fields(tt.input)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Fields(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFieldsFunc(t *testing.T) {
+	isComma := func(g string) bool { return g == "," }
+	got := FieldsFunc("a,👨‍👩‍👧,b", isComma)
+	want := []string{"a", "👨‍👩‍👧", "b"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("FieldsFunc = %q, want %q", got, want)
+	}
+}
+
+func TestTrimFunc(t *testing.T) {
+	isX := func(g string) bool { return g == "x" }
+	tests := []struct {
+		name  string
+		fn    func(string, func(string) bool) string
+		input string
+		want  string
+	}{
+		{"TrimFunc both", TrimFunc, "xxhelloxx", "hello"},
+		{"TrimLeftFunc", TrimLeftFunc, "xxhello", "hello"},
+		{"TrimRightFunc", TrimRightFunc, "helloxx", "hello"},
+		{"no match", TrimFunc, "hello", "hello"},
+		{"all match", TrimFunc, "xxxx", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.fn(tt.input, isX)
+			if got != tt.want {
+				t.Errorf("%s(%q) = %q, want %q", tt.name, tt.input, got, tt.want)
+			}
+		})
+	}
+}
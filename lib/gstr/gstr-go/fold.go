@@ -0,0 +1,157 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/language"
+)
+
+// EqualFold reports whether a and b are equal under full Unicode
+// case-folding, as opposed to CaseCompare/CaseCompareASCII which only
+// fold ASCII letters. This makes "Héllo" and "HÉLLO" compare equal, and
+// likewise for Greek, Cyrillic, Armenian, and German ß/SS - a one-rune-
+// to-many-rune fold, so EqualFold is built on CaseFold's full (C+F)
+// case-fold table rather than unicode.SimpleFold, whose rune-by-rune
+// orbits can never turn "ß" into "ss".
+func EqualFold(a, b string) bool {
+	return CaseFold(a) == CaseFold(b)
+}
+
+// EqualFoldLocale is like EqualFold, but takes a BCP 47 locale hint for
+// the scripts where case folding isn't locale-independent - most
+// notably Turkish/Azerbaijani, where plain "I"/"i" fold differently than
+// the dotted "İ"/dotless "ı" pair every other Latin-script locale uses.
+// Passing "" or an unrecognized locale behaves exactly like EqualFold.
+func EqualFoldLocale(a, b, locale string) bool {
+	if isTurkic(localeTag(locale)) {
+		a, b = foldTurkish(a), foldTurkish(b)
+	}
+	return CaseFold(a) == CaseFold(b)
+}
+
+// FoldCompare compares a and b under full Unicode case-folding,
+// returning negative, zero, or positive as a's folded form is less
+// than, equal to, or greater than b's.
+func FoldCompare(a, b string) int {
+	return strings.Compare(CaseFold(a), CaseFold(b))
+}
+
+// isTurkic reports whether tag's base language is Turkish or
+// Azerbaijani, the two locales with a dotted/dotless I case distinction.
+func isTurkic(tag language.Tag) bool {
+	base, _ := tag.Base()
+	switch base.String() {
+	case "tr", "az":
+		return true
+	}
+	return false
+}
+
+// foldTurkish rewrites the ASCII "I" and dotted "İ" to their Turkish
+// lower-case counterparts before folding, so EqualFoldLocale can tell
+// dotless "I"/"ı" and dotted "İ"/"i" apart the way Turkish does, instead
+// of the locale-independent fold that sends both "I" and "İ" toward "i".
+func foldTurkish(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		switch r {
+		case 'I':
+			b.WriteRune('ı')
+		case 'İ':
+			b.WriteRune('i')
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// foldEqualRune reports whether a and b are the same rune under
+// unicode.SimpleFold's case-fold orbit.
+func foldEqualRune(a, b rune) bool {
+	if a == b {
+		return true
+	}
+	for r := unicode.SimpleFold(a); r != a; r = unicode.SimpleFold(r) {
+		if r == b {
+			return true
+		}
+	}
+	return false
+}
+
+// foldKey returns a canonical representative of r's case-fold orbit, so
+// that two runes in the same orbit always produce the same key and keys
+// order consistently across calls.
+func foldKey(r rune) rune {
+	min := r
+	for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+		if f < min {
+			min = f
+		}
+	}
+	return min
+}
+
+// foldHasPrefix reports whether s begins with prefix under simple
+// (rune-by-rune) Unicode case folding.
+func foldHasPrefix(s, prefix string) bool {
+	for len(prefix) > 0 {
+		if len(s) == 0 {
+			return false
+		}
+		rs, ss := utf8.DecodeRuneInString(s)
+		rp, sp := utf8.DecodeRuneInString(prefix)
+		if !foldEqualRune(rs, rp) {
+			return false
+		}
+		s = s[ss:]
+		prefix = prefix[sp:]
+	}
+	return true
+}
+
+// IndexFold returns the byte index of the first occurrence of needle in
+// s under full Unicode case folding, or -1 if not found. Like
+// IndexCaseFold (which this delegates to), a match may span a different
+// number of bytes than needle - needle "ss" matches a "ß" in s, for
+// example.
+func IndexFold(s, needle string) int {
+	return IndexCaseFold(s, needle)
+}
+
+// HasPrefixFold reports whether s begins with prefix under simple
+// (rune-by-rune) Unicode case folding - it does not catch one-to-many
+// folds such as "ß" matching "ss"; use IndexCaseFold(s, prefix) == 0 for
+// that.
+func HasPrefixFold(s, prefix string) bool {
+	return foldHasPrefix(s, prefix)
+}
+
+// HasSuffixFold reports whether s ends with suffix under simple
+// (rune-by-rune) Unicode case folding - see HasPrefixFold.
+func HasSuffixFold(s, suffix string) bool {
+	rs := []rune(s)
+	rsuf := []rune(suffix)
+	if len(rsuf) > len(rs) {
+		return false
+	}
+	offset := len(rs) - len(rsuf)
+	for i, r := range rsuf {
+		if !foldEqualRune(rs[offset+i], r) {
+			return false
+		}
+	}
+	return true
+}
+
+// ContainsFold reports whether substr is within s under full Unicode
+// case folding.
+func ContainsFold(s, substr string) bool {
+	return IndexFold(s, substr) >= 0
+}
@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import "testing"
+
+func TestEqualFold(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want bool
+	}{
+		{"ascii", "Hello", "HELLO", true},
+		{"latin accented", "Héllo", "HÉLLO", true},
+		{"greek", "Σίσυφος", "ΣΊΣΥΦΟΣ", true},
+		{"cyrillic", "Привет", "ПРИВЕТ", true},
+		{"armenian", "Արամ", "արամ", true},
+		{"german sharp s", "straße", "STRASSE", true},
+		{"different", "Hello", "World", false},
+		{"different length", "Hello", "Hell", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := EqualFold(tt.a, tt.b)
+			if got != tt.want {
+				t.Errorf("EqualFold(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEqualFoldLocale(t *testing.T) {
+	tests := []struct {
+		name   string
+		a, b   string
+		locale string
+		want   bool
+	}{
+		{"turkish dotless I does not fold to i", "I", "i", "tr", false},
+		{"turkish dotless I folds to itself", "I", "ı", "tr", true},
+		{"turkish dotted İ folds to i", "İ", "i", "tr", true},
+		{"azerbaijani behaves like turkish", "I", "i", "az", false},
+		{"default locale falls back to EqualFold", "I", "i", "", true},
+		{"unrecognized locale falls back to EqualFold", "I", "i", "xx", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := EqualFoldLocale(tt.a, tt.b, tt.locale)
+			if got != tt.want {
+				t.Errorf("EqualFoldLocale(%q, %q, %q) = %v, want %v", tt.a, tt.b, tt.locale, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFoldCompare(t *testing.T) {
+	if FoldCompare("Héllo", "héllo") != 0 {
+		t.Errorf("FoldCompare(Héllo, héllo) != 0")
+	}
+	if FoldCompare("abc", "ABD") >= 0 {
+		t.Errorf("FoldCompare(abc, ABD) should be negative")
+	}
+}
+
+func TestIndexFoldUnicode(t *testing.T) {
+	tests := []struct {
+		name   string
+		s, sub string
+		want   int
+	}{
+		{"ascii", "Hello, World!", "WORLD", 7},
+		{"latin accented", "café HÉLLO", "héllo", 6},
+		{"greek", "λόγος ΣΟΦΙΑ", "σοφια", 11},
+		{"not found", "Hello", "xyz", -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := IndexFold(tt.s, tt.sub)
+			if got != tt.want {
+				t.Errorf("IndexFold(%q, %q) = %d, want %d", tt.s, tt.sub, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHasPrefixSuffixContainsFold(t *testing.T) {
+	if !HasPrefixFold("HÉLLO world", "héllo") {
+		t.Error("HasPrefixFold should match accented fold")
+	}
+	if !HasSuffixFold("hello WÖRLD", "wörld") {
+		t.Error("HasSuffixFold should match accented fold")
+	}
+	if !ContainsFold("say HÉLLO now", "héllo") {
+		t.Error("ContainsFold should match accented fold")
+	}
+}
@@ -251,7 +251,16 @@ func CompareN(a, b string, n int) int {
 }
 
 // CaseCompare compares two strings case-insensitively (ASCII only).
+// For full Unicode case folding (Latin, Greek, Cyrillic, German ß, etc.)
+// use FoldCompare instead.
 func CaseCompare(a, b string) int {
+	return CaseCompareASCII(a, b)
+}
+
+// CaseCompareASCII compares two strings case-insensitively, folding only
+// ASCII letters. It is the cheap path for callers who know their input
+// is ASCII; everyone else should use FoldCompare.
+func CaseCompareASCII(a, b string) int {
 	ca := C.CString(a)
 	cb := C.CString(b)
 	defer C.free(unsafe.Pointer(ca))
@@ -352,9 +361,10 @@ func LastIndexSubstring(s, needle string) int {
 	return int(C.gstr_shim_rstr(cs, C.size_t(len(s)), cn, C.size_t(len(needle))))
 }
 
-// IndexFold returns the byte index of the first case-insensitive occurrence
-// of needle in s, or -1 if not found.
-func IndexFold(s, needle string) int {
+// IndexFoldASCII returns the byte index of the first case-insensitive
+// occurrence of needle in s, folding only ASCII letters, or -1 if not
+// found. For full Unicode case folding use IndexFold instead.
+func IndexFoldASCII(s, needle string) int {
 	if len(needle) == 0 {
 		return 0
 	}
@@ -677,3 +687,33 @@ func (it *GraphemeIterator) Next() string {
 func (it *GraphemeIterator) Reset() {
 	it.pos = 0
 }
+
+// graphemeBoundariesFast returns the byte offset of every grapheme
+// boundary in s, starting with 0 and ending with len(s), in a single
+// cgo call. Unlike walking a GraphemeIterator to completion - whose
+// Next() re-encodes the whole string into a fresh C buffer on every
+// call - this C.CString's s exactly once and makes one tight loop of
+// gstr_shim_utf8_next_grapheme calls against that one buffer, so
+// building an index over an n-grapheme string costs O(n), not O(n^2).
+func graphemeBoundariesFast(s string) []int {
+	offs := make([]int, 1, len(s)+1)
+	offs[0] = 0
+	if len(s) == 0 {
+		return offs
+	}
+
+	cs := C.CString(s)
+	defer C.free(unsafe.Pointer(cs))
+
+	byteLen := C.int(len(s))
+	pos := 0
+	for pos < len(s) {
+		next := int(C.gstr_shim_utf8_next_grapheme(cs, byteLen, C.int(pos)))
+		if next <= pos || next > len(s) {
+			break
+		}
+		offs = append(offs, next)
+		pos = next
+	}
+	return offs
+}
@@ -0,0 +1,148 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import "sort"
+
+// GraphemeIndex is a prebuilt grapheme-cluster-to-byte-offset table for a
+// string, built in one O(n) pass via graphemeBoundariesFast. Once
+// built, At, Offset and Slice are O(1) for a dense index (NewIndex) or
+// O(K) for a sparse one (NewSparseIndex with stride K) - unlike the
+// package-level At and Offset, which are O(n) per call and so O(n^2) if
+// called in a loop.
+type GraphemeIndex struct {
+	s       string
+	count   int
+	offsets []int32 // every stride-th grapheme boundary's byte offset
+	stride  int
+}
+
+// NewIndex builds a dense GraphemeIndex over s: every grapheme boundary is
+// stored, so At and Offset are O(1).
+func NewIndex(s string) *GraphemeIndex {
+	return NewSparseIndex(s, 1)
+}
+
+// NewSparseIndex builds a GraphemeIndex that stores only every stride-th
+// grapheme boundary, resuming a forward scan from the nearest stored
+// anchor to answer queries between them. This bounds the index's memory
+// to roughly len(s)/stride int32s, at the cost of up to stride-1 extra
+// grapheme steps per query. stride <= 1 behaves like NewIndex.
+func NewSparseIndex(s string, stride int) *GraphemeIndex {
+	if stride < 1 {
+		stride = 1
+	}
+	full := graphemeBoundariesFast(s)
+	count := len(full) - 1
+
+	offsets := make([]int32, 0, count/stride+2)
+	for i := 0; i < len(full); i += stride {
+		offsets = append(offsets, int32(full[i]))
+	}
+	if last := (len(offsets) - 1) * stride; last != count {
+		offsets = append(offsets, int32(full[count]))
+	}
+	return &GraphemeIndex{s: s, count: count, offsets: offsets, stride: stride}
+}
+
+// Len returns the number of grapheme clusters in the indexed string.
+func (ix *GraphemeIndex) Len() int {
+	return ix.count
+}
+
+// At returns the nth grapheme cluster (0-indexed), or "" if n is out of
+// range.
+func (ix *GraphemeIndex) At(n int) string {
+	if n < 0 || n >= ix.count {
+		return ""
+	}
+	return ix.s[ix.byteOffsetAt(n):ix.byteOffsetAt(n+1)]
+}
+
+// Offset returns the byte offset of the nth grapheme cluster (0-indexed).
+// Returns len(s) if n is beyond the end.
+func (ix *GraphemeIndex) Offset(n int) int {
+	if n < 0 {
+		return 0
+	}
+	return ix.byteOffsetAt(n)
+}
+
+// Slice returns the substring spanning grapheme clusters [startG, endG).
+func (ix *GraphemeIndex) Slice(startG, endG int) string {
+	if startG < 0 {
+		startG = 0
+	}
+	if endG > ix.count {
+		endG = ix.count
+	}
+	if startG >= endG {
+		return ""
+	}
+	return ix.s[ix.byteOffsetAt(startG):ix.byteOffsetAt(endG)]
+}
+
+// Width returns the display width, in terminal columns, of the
+// grapheme-cluster range [startG, endG).
+func (ix *GraphemeIndex) Width(startG, endG int) int {
+	return Width(ix.Slice(startG, endG))
+}
+
+// byteOffsetAt returns the byte offset of grapheme boundary n, resuming
+// a forward scan from the nearest stored anchor at or before n.
+func (ix *GraphemeIndex) byteOffsetAt(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	if n >= ix.count {
+		return len(ix.s)
+	}
+	anchorIdx := n / ix.stride
+	if anchorIdx >= len(ix.offsets) {
+		anchorIdx = len(ix.offsets) - 1
+	}
+	pos := int(ix.offsets[anchorIdx])
+	remaining := n - anchorIdx*ix.stride
+	if remaining <= 0 {
+		return pos
+	}
+	it := Graphemes(ix.s[pos:])
+	for i := 0; i < remaining; i++ {
+		pos += len(it.Next())
+	}
+	return pos
+}
+
+// graphemeIndexAtByte returns the index of the grapheme cluster
+// containing byte offset off - the nearest grapheme boundary at or
+// before off.
+func (ix *GraphemeIndex) graphemeIndexAtByte(off int) int {
+	if off <= 0 {
+		return 0
+	}
+	if off >= len(ix.s) {
+		return ix.count
+	}
+
+	i := sort.Search(len(ix.offsets), func(i int) bool { return int(ix.offsets[i]) > off }) - 1
+	if i < 0 {
+		i = 0
+	}
+	idx := i * ix.stride
+	pos := int(ix.offsets[i])
+	it := Graphemes(ix.s[pos:])
+	for pos < off {
+		g := it.Next()
+		if g == "" {
+			break
+		}
+		next := pos + len(g)
+		if next > off {
+			break
+		}
+		pos = next
+		idx++
+	}
+	return idx
+}
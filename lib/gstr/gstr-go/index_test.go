@@ -0,0 +1,93 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import "testing"
+
+func TestIndexMatchesAtAndOffset(t *testing.T) {
+	tests := []string{
+		"hello",
+		"",
+		"é",
+		"👨‍👩‍👧👋🎉",
+		"🇺🇸🇨🇦",
+		"áb",
+	}
+
+	for _, s := range tests {
+		ix := NewIndex(s)
+		n := Len(s)
+		if ix.Len() != n {
+			t.Errorf("Index(%q).Len() = %d, want %d", s, ix.Len(), n)
+		}
+		for i := 0; i <= n; i++ {
+			if got, want := ix.Offset(i), Offset(s, i); got != want {
+				t.Errorf("Index(%q).Offset(%d) = %d, want %d", s, i, got, want)
+			}
+		}
+		for i := 0; i < n; i++ {
+			if got, want := ix.At(i), At(s, i); got != want {
+				t.Errorf("Index(%q).At(%d) = %q, want %q", s, i, got, want)
+			}
+		}
+		if got := ix.At(-1); got != "" {
+			t.Errorf("Index.At(-1) = %q, want empty", got)
+		}
+		if got := ix.At(n); got != "" {
+			t.Errorf("Index.At(len) = %q, want empty", got)
+		}
+	}
+}
+
+func TestIndexSliceAndWidth(t *testing.T) {
+	s := "héllo 👋 world"
+	ix := NewIndex(s)
+	if got, want := ix.Slice(0, 5), Sub(s, 0, 5); got != want {
+		t.Errorf("Index.Slice(0, 5) = %q, want %q", got, want)
+	}
+	if got, want := ix.Width(0, 5), Width(Sub(s, 0, 5)); got != want {
+		t.Errorf("Index.Width(0, 5) = %d, want %d", got, want)
+	}
+	if got := ix.Slice(3, 1); got != "" {
+		t.Errorf("Index.Slice with start >= end = %q, want empty", got)
+	}
+}
+
+func TestSparseIndexMatchesDenseIndex(t *testing.T) {
+	s := "the quick 🦊 jumps over 👨‍👩‍👧 and 🇺🇸🇨🇦 flags"
+	dense := NewIndex(s)
+	for _, stride := range []int{2, 3, 5} {
+		sparse := NewSparseIndex(s, stride)
+		if sparse.Len() != dense.Len() {
+			t.Fatalf("NewSparseIndex(stride=%d).Len() = %d, want %d", stride, sparse.Len(), dense.Len())
+		}
+		for i := 0; i <= sparse.Len(); i++ {
+			if got, want := sparse.Offset(i), dense.Offset(i); got != want {
+				t.Errorf("stride=%d: Offset(%d) = %d, want %d", stride, i, got, want)
+			}
+		}
+		for i := 0; i < sparse.Len(); i++ {
+			if got, want := sparse.At(i), dense.At(i); got != want {
+				t.Errorf("stride=%d: At(%d) = %q, want %q", stride, i, got, want)
+			}
+		}
+	}
+}
+
+func TestIndexGraphemeIndexAtByte(t *testing.T) {
+	s := "a👨‍👩‍👧b"
+	ix := NewIndex(s)
+	for n := 0; n <= ix.Len(); n++ {
+		off := ix.Offset(n)
+		if got := ix.graphemeIndexAtByte(off); got != n {
+			t.Errorf("graphemeIndexAtByte(%d) = %d, want %d (exact boundary)", off, got, n)
+		}
+	}
+	// A byte offset that falls inside the ZWJ sequence's first cluster
+	// should resolve to the boundary before it, not the one after.
+	mid := ix.Offset(1) + 1
+	if got, want := ix.graphemeIndexAtByte(mid), 1; got != want {
+		t.Errorf("graphemeIndexAtByte(%d) = %d, want %d", mid, got, want)
+	}
+}
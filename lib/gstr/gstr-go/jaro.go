@@ -0,0 +1,156 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+// JaroOptions configures the grapheme comparison used by
+// JaroSimilarityOpts and JaroWinklerSimilarityOpts.
+type JaroOptions struct {
+	// Normalize, when true, normalizes both inputs to NormalizeForm
+	// before splitting them into grapheme clusters, so a precomposed
+	// "é" and a decomposed "e"+COMBINING ACUTE ACCENT compare as the
+	// same cluster.
+	Normalize     bool
+	NormalizeForm NormForm
+}
+
+// JaroSimilarity returns the Jaro similarity of a and b, a value in
+// [0, 1] where 1 means identical, computed over grapheme clusters
+// rather than bytes or runes - so a family emoji built from a ZWJ
+// sequence counts as a single unit on either side of the comparison.
+// It matches the semantics of Erlang's string:jaro_similarity/2.
+func JaroSimilarity(a, b string) float64 {
+	return jaroSimilarity(graphemeSlice(a), graphemeSlice(b))
+}
+
+// JaroSimilarityOpts is like JaroSimilarity, but normalizes both inputs
+// first when opts.Normalize is set.
+func JaroSimilarityOpts(a, b string, opts JaroOptions) float64 {
+	if opts.Normalize {
+		a = Normalize(a, opts.NormalizeForm)
+		b = Normalize(b, opts.NormalizeForm)
+	}
+	return jaroSimilarity(graphemeSlice(a), graphemeSlice(b))
+}
+
+// JaroWinklerSimilarity returns the Jaro-Winkler similarity of a and b,
+// which boosts JaroSimilarity's score by up to a shared leading run of
+// at most 4 grapheme clusters. p is the scaling factor for that boost,
+// clamped to [0, 0.25]; 0.1 is the conventional default.
+func JaroWinklerSimilarity(a, b string, p float64) float64 {
+	ag, bg := graphemeSlice(a), graphemeSlice(b)
+	return jaroWinkler(ag, bg, p)
+}
+
+// JaroWinklerSimilarityOpts is like JaroWinklerSimilarity, but
+// normalizes both inputs first when opts.Normalize is set.
+func JaroWinklerSimilarityOpts(a, b string, p float64, opts JaroOptions) float64 {
+	if opts.Normalize {
+		a = Normalize(a, opts.NormalizeForm)
+		b = Normalize(b, opts.NormalizeForm)
+	}
+	ag, bg := graphemeSlice(a), graphemeSlice(b)
+	return jaroWinkler(ag, bg, p)
+}
+
+// graphemeSlice splits s into its grapheme clusters.
+func graphemeSlice(s string) []string {
+	var out []string
+	it := Graphemes(s)
+	for {
+		g := it.Next()
+		if g == "" {
+			break
+		}
+		out = append(out, g)
+	}
+	return out
+}
+
+// jaroSimilarity implements the Jaro similarity algorithm over two
+// already-segmented grapheme-cluster slices.
+func jaroSimilarity(a, b []string) float64 {
+	la, lb := len(a), len(b)
+	if la == 0 && lb == 0 {
+		return 1.0
+	}
+	if la == 0 || lb == 0 {
+		return 0.0
+	}
+
+	w := la
+	if lb > w {
+		w = lb
+	}
+	w = w/2 - 1
+	if w < 0 {
+		w = 0
+	}
+
+	aMatched := make([]bool, la)
+	bMatched := make([]bool, lb)
+	matches := 0
+	for i := 0; i < la; i++ {
+		lo := i - w
+		if lo < 0 {
+			lo = 0
+		}
+		hi := i + w + 1
+		if hi > lb {
+			hi = lb
+		}
+		for j := lo; j < hi; j++ {
+			if bMatched[j] || a[i] != b[j] {
+				continue
+			}
+			aMatched[i] = true
+			bMatched[j] = true
+			matches++
+			break
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+
+	aMatches := make([]string, 0, matches)
+	for i, matched := range aMatched {
+		if matched {
+			aMatches = append(aMatches, a[i])
+		}
+	}
+	bMatches := make([]string, 0, matches)
+	for j, matched := range bMatched {
+		if matched {
+			bMatches = append(bMatches, b[j])
+		}
+	}
+	transpositions := 0
+	for i := range aMatches {
+		if aMatches[i] != bMatches[i] {
+			transpositions++
+		}
+	}
+	transpositions /= 2
+
+	m := float64(matches)
+	t := float64(transpositions)
+	return (m/float64(la) + m/float64(lb) + (m-t)/m) / 3
+}
+
+// jaroWinkler applies the Winkler prefix boost to the Jaro similarity of
+// two already-segmented grapheme-cluster slices.
+func jaroWinkler(a, b []string, p float64) float64 {
+	jaro := jaroSimilarity(a, b)
+	if p < 0 {
+		p = 0
+	} else if p > 0.25 {
+		p = 0.25
+	}
+
+	l := 0
+	for l < len(a) && l < len(b) && l < 4 && a[l] == b[l] {
+		l++
+	}
+	return jaro + float64(l)*p*(1-jaro)
+}
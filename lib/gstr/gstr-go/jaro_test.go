@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import (
+	"math"
+	"testing"
+)
+
+func almostEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestJaroSimilarity(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b string
+		want float64
+	}{
+		{"both empty", "", "", 1.0},
+		{"one empty", "abc", "", 0.0},
+		{"identical", "hello", "hello", 1.0},
+		{"no common graphemes", "abc", "xyz", 0.0},
+		{"classic martha mhta", "MARTHA", "MARHTA", 0.9444444444444445},
+		{"classic dwayne duane", "DWAYNE", "DUANE", 0.8222222222222223},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := JaroSimilarity(tt.a, tt.b)
+			if !almostEqual(got, tt.want) {
+				t.Errorf("JaroSimilarity(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJaroSimilarityGraphemeClusters(t *testing.T) {
+	// A family emoji built from a ZWJ sequence must compare as a single
+	// cluster, not as several runes.
+	got := JaroSimilarity("👨‍👩‍👧", "👨‍👩‍👧")
+	if got != 1.0 {
+		t.Errorf("JaroSimilarity of identical ZWJ sequences = %v, want 1.0", got)
+	}
+}
+
+func TestJaroWinklerSimilarity(t *testing.T) {
+	jaro := JaroSimilarity("MARTHA", "MARHTA")
+	winkler := JaroWinklerSimilarity("MARTHA", "MARHTA", 0.1)
+	if winkler <= jaro {
+		t.Errorf("JaroWinklerSimilarity = %v, want greater than plain Jaro %v for a shared prefix", winkler, jaro)
+	}
+}
+
+func TestJaroWinklerSimilarityPClamped(t *testing.T) {
+	a, b := "prefix-abc", "prefix-xyz"
+	high := JaroWinklerSimilarity(a, b, 10)
+	clamped := JaroWinklerSimilarity(a, b, 0.25)
+	if !almostEqual(high, clamped) {
+		t.Errorf("JaroWinklerSimilarity with p=10 = %v, want same as p=0.25 (%v)", high, clamped)
+	}
+}
+
+func TestJaroSimilarityOptsNormalizes(t *testing.T) {
+	precomposed := eAcutePrecomposed
+	decomposed := eAcuteDecomposed
+	if JaroSimilarity(precomposed, decomposed) == 1.0 {
+		t.Fatal("precomposed and decomposed é should not already compare equal without normalization")
+	}
+	got := JaroSimilarityOpts(precomposed, decomposed, JaroOptions{Normalize: true, NormalizeForm: NormNFC})
+	if got != 1.0 {
+		t.Errorf("JaroSimilarityOpts with NFC normalization = %v, want 1.0", got)
+	}
+}
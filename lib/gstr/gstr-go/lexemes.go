@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+// Lexemes splits s around runs of any grapheme cluster found in seps,
+// collapsing consecutive separators and dropping empty results -
+// mirroring Erlang's string:lexemes/2, which takes a set of separator
+// characters rather than the single literal separator Split matches.
+func Lexemes(s, seps string) []string {
+	sepSet := make(map[string]bool)
+	it := Graphemes(seps)
+	for {
+		g := it.Next()
+		if g == "" {
+			break
+		}
+		sepSet[g] = true
+	}
+	return FieldsFunc(s, func(g string) bool {
+		return sepSet[g]
+	})
+}
@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLexemes(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		seps string
+		want []string
+	}{
+		{"single separator", "a,b,c", ",", []string{"a", "b", "c"}},
+		{"multiple separators", "a,b;c d", ",; ", []string{"a", "b", "c", "d"}},
+		{"collapses runs", "a,,b", ",", []string{"a", "b"}},
+		{"leading and trailing drop", ",a,b,", ",", []string{"a", "b"}},
+		{"no separators present", "abc", ",", []string{"abc"}},
+		{"faces as separators", "😀a😁b", "😀😁", []string{"a", "b"}},
+		{"empty seps returns whole string", "a,b", "", []string{"a,b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Lexemes(tt.s, tt.seps)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Lexemes(%q, %q) = %q, want %q", tt.s, tt.seps, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import (
+	"golang.org/x/text/cases"
+	"golang.org/x/text/collate"
+	"golang.org/x/text/language"
+)
+
+// Collator compares and sorts strings according to the conventions of a
+// specific locale - Turkish dotted/dotless i, German ß, Swedish å
+// ordering, and so on - by wrapping golang.org/x/text/collate. Unlike
+// the byte-wise Compare, a Collator value understands that collation
+// order is locale-dependent; it's safe to reuse across many comparisons.
+type Collator struct {
+	c   *collate.Collator
+	buf collate.Buffer
+}
+
+// NewCollator returns a Collator for the given locale tag.
+func NewCollator(tag language.Tag) *Collator {
+	return &Collator{c: collate.New(tag)}
+}
+
+// Compare compares a and b according to the Collator's locale rules,
+// returning negative, zero, or positive as Compare does.
+func (c *Collator) Compare(a, b string) int {
+	return c.c.CompareString(a, b)
+}
+
+// Key returns a sortable collation key for s. Comparing two keys
+// byte-wise gives the same order as c.Compare, which makes Key useful
+// for building a sorted index without repeated locale-aware comparisons.
+// The returned slice is only valid until the next call to Key on the
+// same Collator; copy it if you need to retain it.
+func (c *Collator) Key(s string) []byte {
+	c.buf.Reset()
+	return c.c.KeyFromString(&c.buf, s)
+}
+
+// localeTag parses a BCP 47 locale string such as "tr" or "az", falling
+// back to the undetermined locale if it doesn't parse.
+func localeTag(locale string) language.Tag {
+	tag, err := language.Parse(locale)
+	if err != nil {
+		return language.Und
+	}
+	return tag
+}
+
+// ToLowerLocale returns s converted to lower case following the given
+// locale's special casing rules - for example Turkish/Azerbaijani "tr"
+// and "az" map "I" to dotless "ı" rather than "i".
+func ToLowerLocale(s, locale string) string {
+	return cases.Lower(localeTag(locale)).String(s)
+}
+
+// ToUpperLocale returns s converted to upper case following the given
+// locale's special casing rules.
+func ToUpperLocale(s, locale string) string {
+	return cases.Upper(localeTag(locale)).String(s)
+}
+
+// ToTitleLocale returns s converted to title case following the given
+// locale's special casing rules.
+func ToTitleLocale(s, locale string) string {
+	return cases.Title(localeTag(locale)).String(s)
+}
+
+// ToLowerUnicode returns s converted to lower case using the full
+// Unicode case mapping rules (unlike ToLower, which only maps ASCII
+// letters), but without any locale's special casing - so "I" lowers to
+// ASCII "i" rather than Turkish dotless "ı". Use ToLowerLocale when a
+// locale's special casing is required.
+func ToLowerUnicode(s string) string {
+	return cases.Lower(language.Und).String(s)
+}
+
+// ToUpperUnicode returns s converted to upper case using the full
+// Unicode case mapping rules, without any locale's special casing.
+func ToUpperUnicode(s string) string {
+	return cases.Upper(language.Und).String(s)
+}
+
+// ToTitleUnicode returns s converted to title case using the full
+// Unicode case mapping rules, without any locale's special casing.
+func ToTitleUnicode(s string) string {
+	return cases.Title(language.Und).String(s)
+}
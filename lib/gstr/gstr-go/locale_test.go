@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestCollatorCompare(t *testing.T) {
+	sv := NewCollator(language.Swedish)
+	if sv.Compare("a", "å") >= 0 {
+		t.Error("Swedish collation should sort å after a")
+	}
+}
+
+func TestCollatorKeyOrdersLikeCompare(t *testing.T) {
+	c := NewCollator(language.English)
+	a, b := c.Key("apple"), c.Key("banana")
+	cmp := c.Compare("apple", "banana")
+	if cmp < 0 && string(a) >= string(b) {
+		t.Error("Key ordering should agree with Compare")
+	}
+}
+
+func TestToLowerLocale(t *testing.T) {
+	if got := ToLowerLocale("I", "tr"); got == "i" {
+		t.Error("Turkish lowering of I should produce dotless ı, not ASCII i")
+	}
+	if got := ToLowerLocale("HELLO", "en"); got != "hello" {
+		t.Errorf("ToLowerLocale(HELLO, en) = %q, want hello", got)
+	}
+}
+
+func TestToUpperTitleLocale(t *testing.T) {
+	if got := ToUpperLocale("hello", "en"); got != "HELLO" {
+		t.Errorf("ToUpperLocale = %q, want HELLO", got)
+	}
+	if got := ToTitleLocale("hello world", "en"); got != "Hello World" {
+		t.Errorf("ToTitleLocale = %q, want Hello World", got)
+	}
+}
+
+func TestToLowerUpperTitleUnicode(t *testing.T) {
+	if got := ToLowerUnicode("Ä"); got != "ä" {
+		t.Errorf("ToLowerUnicode(Ä) = %q, want ä", got)
+	}
+	if got := ToLowerUnicode("I"); got != "i" {
+		t.Error("ToLowerUnicode should map I to ASCII i without a locale's special casing")
+	}
+	if got := ToUpperUnicode("straße"); got != "STRASSE" {
+		t.Errorf("ToUpperUnicode(straße) = %q, want STRASSE", got)
+	}
+	if got := ToTitleUnicode("étoile"); got != "Étoile" {
+		t.Errorf("ToTitleUnicode(étoile) = %q, want Étoile", got)
+	}
+}
@@ -0,0 +1,264 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"unicode/utf8"
+)
+
+// globToken is one parsed element of a glob pattern: a literal grapheme
+// cluster, a single-cluster wildcard ('?'), a single-segment multi-
+// cluster wildcard ('*'), a multi-segment wildcard ('D', from "**"), or
+// a character class ('[...]').
+type globToken struct {
+	kind   byte // 'L', '?', '*', 'D', or '['
+	lit    string
+	chars  []rune
+	ranges [][2]rune
+	negate bool
+}
+
+// parseGlobPattern tokenizes pattern into a sequence of globTokens,
+// splitting literal runs on grapheme-cluster boundaries so a literal
+// token is never a partial cluster. A run of two or more consecutive
+// '*' collapses into a single "**" (kind 'D') token.
+func parseGlobPattern(pattern string) ([]globToken, error) {
+	var toks []globToken
+	i := 0
+	for i < len(pattern) {
+		switch pattern[i] {
+		case '*':
+			j := i
+			for j < len(pattern) && pattern[j] == '*' {
+				j++
+			}
+			kind := byte('*')
+			if j-i >= 2 {
+				kind = 'D'
+			}
+			toks = append(toks, globToken{kind: kind})
+			i = j
+		case '?':
+			toks = append(toks, globToken{kind: '?'})
+			i++
+		case '[':
+			j := i + 1
+			negate := false
+			if j < len(pattern) && (pattern[j] == '^' || pattern[j] == '!') {
+				negate = true
+				j++
+			}
+			start := j
+			if j < len(pattern) && pattern[j] == ']' {
+				j++
+			}
+			for j < len(pattern) && pattern[j] != ']' {
+				j++
+			}
+			if j >= len(pattern) {
+				return nil, fmt.Errorf("gstr: unterminated character class in pattern %q", pattern)
+			}
+			chars, ranges := parseGlobClass(pattern[start:j])
+			toks = append(toks, globToken{kind: '[', chars: chars, ranges: ranges, negate: negate})
+			i = j + 1
+		default:
+			it := &GraphemeIterator{s: pattern, pos: i}
+			g := it.Next()
+			if g == "" {
+				i++
+				continue
+			}
+			toks = append(toks, globToken{kind: 'L', lit: g})
+			i = it.pos
+		}
+	}
+	return toks, nil
+}
+
+// parseGlobClass parses the contents of a "[...]" character class into
+// individual runes and "a-z" style ranges.
+func parseGlobClass(s string) (chars []rune, ranges [][2]rune) {
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		if i+2 < len(runes) && runes[i+1] == '-' {
+			ranges = append(ranges, [2]rune{runes[i], runes[i+2]})
+			i += 2
+			continue
+		}
+		chars = append(chars, runes[i])
+	}
+	return chars, ranges
+}
+
+// matchToken reports whether cluster satisfies a single non-wildcard
+// glob token.
+func matchToken(tok globToken, cluster string, fold bool) bool {
+	switch tok.kind {
+	case '?':
+		return true
+	case 'L':
+		if fold {
+			return EqualFold(tok.lit, cluster)
+		}
+		return tok.lit == cluster
+	case '[':
+		r, _ := utf8.DecodeRuneInString(cluster)
+		in := false
+		for _, c := range tok.chars {
+			if c == r || (fold && foldEqualRune(c, r)) {
+				in = true
+				break
+			}
+		}
+		if !in {
+			for _, rg := range tok.ranges {
+				if r >= rg[0] && r <= rg[1] {
+					in = true
+					break
+				}
+			}
+		}
+		if tok.negate {
+			return !in
+		}
+		return in
+	}
+	return false
+}
+
+// isStar reports whether tok is either wildcard kind ('*' or 'D').
+func isStar(tok globToken) bool {
+	return tok.kind == '*' || tok.kind == 'D'
+}
+
+// matchGlob runs the classic greedy-with-backtrack wildcard match over
+// tokens and clusters: '?' and literal/class tokens consume exactly one
+// cluster, '*' and 'D' ("**") consume zero or more.
+//
+// sep marks a path-separator cluster: when sep is non-empty, a '*'
+// cannot extend across a cluster equal to sep, while 'D' can - so "**"
+// spans separator-delimited segments and a lone "*" stays within one.
+// sep is ignored (every wildcard behaves like plain "*") when empty.
+func matchGlob(toks []globToken, clusters []string, fold bool, sep string) bool {
+	ti, ci := 0, 0
+	starTi, starCi := -1, -1
+	for ci < len(clusters) {
+		switch {
+		case ti < len(toks) && !isStar(toks[ti]) && matchToken(toks[ti], clusters[ci], fold):
+			ti++
+			ci++
+		case ti < len(toks) && isStar(toks[ti]):
+			starTi, starCi = ti, ci
+			ti++
+		case starTi != -1:
+			if toks[starTi].kind == '*' && sep != "" && clusters[starCi] == sep {
+				return false
+			}
+			ti = starTi + 1
+			starCi++
+			ci = starCi
+		default:
+			return false
+		}
+	}
+	for ti < len(toks) && isStar(toks[ti]) {
+		ti++
+	}
+	return ti == len(toks)
+}
+
+// clustersOf returns the grapheme clusters of s as a slice.
+func clustersOf(s string) []string {
+	out := make([]string, 0, Len(s))
+	it := Graphemes(s)
+	for {
+		g := it.Next()
+		if g == "" {
+			break
+		}
+		out = append(out, g)
+	}
+	return out
+}
+
+// Match reports whether s matches the shell-style glob pattern, where
+// "*" matches zero or more grapheme clusters, "?" matches exactly one
+// grapheme cluster, and "[abc]"/"[^a-z]" match a single cluster against
+// a set or range of runes - all at grapheme-cluster granularity, so a
+// "?" can never eat half of a multi-byte cluster. A run of consecutive
+// "*" is equivalent to a single one; use MatchPath for "**" to mean
+// "any number of path segments".
+func Match(pattern, s string) (bool, error) {
+	toks, err := parseGlobPattern(pattern)
+	if err != nil {
+		return false, err
+	}
+	return matchGlob(toks, clustersOf(s), false, ""), nil
+}
+
+// MatchFold is like Match but compares literal clusters under full
+// Unicode case folding.
+func MatchFold(pattern, s string) (bool, error) {
+	toks, err := parseGlobPattern(pattern)
+	if err != nil {
+		return false, err
+	}
+	return matchGlob(toks, clustersOf(s), true, ""), nil
+}
+
+// MatchPath is like Match, but sep names a path-separator cluster (such
+// as "/"): a lone "*" cannot match across a sep cluster, while "**"
+// matches zero or more clusters including sep, letting it span multiple
+// path segments - the same distinction shells and path.Match-style
+// globbing draw between "*" and "**". sep must be a single grapheme
+// cluster. Passing "" falls back to Match's ordinary, non-path-aware
+// semantics, where "**" behaves the same as "*". Any sep clusters
+// written around "**" in pattern are literal and still have to be
+// present in s - "a/**/c" matches "a/b/c" but not "a/c".
+func MatchPath(pattern, s, sep string) (bool, error) {
+	toks, err := parseGlobPattern(pattern)
+	if err != nil {
+		return false, err
+	}
+	return matchGlob(toks, clustersOf(s), false, sep), nil
+}
+
+// MatchPathFold is like MatchPath but compares literal clusters under
+// full Unicode case folding.
+func MatchPathFold(pattern, s, sep string) (bool, error) {
+	toks, err := parseGlobPattern(pattern)
+	if err != nil {
+		return false, err
+	}
+	return matchGlob(toks, clustersOf(s), true, sep), nil
+}
+
+// RegexpFind runs re against s and, if a match is found, also reports
+// the match's grapheme-cluster start and end indices (as opposed to
+// re's native byte offsets), so callers can safely index into s at the
+// cluster level.
+func RegexpFind(re *regexp.Regexp, s string) (match string, clusterStart, clusterEnd int, found bool) {
+	loc := re.FindStringIndex(s)
+	if loc == nil {
+		return "", -1, -1, false
+	}
+	offs := graphemeBoundaries(s)
+	return s[loc[0]:loc[1]], byteToClusterIndex(offs, loc[0]), byteToClusterIndex(offs, loc[1]), true
+}
+
+// RegexpReplace replaces all matches of re in s with repl, as
+// re.ReplaceAllString does. It's provided alongside RegexpFind for a
+// symmetrical regexp-based API on gstr.
+func RegexpReplace(re *regexp.Regexp, s, repl string) string {
+	return re.ReplaceAllString(s, repl)
+}
+
+// byteToClusterIndex returns the grapheme-cluster index corresponding to
+// byteOff, given the sorted grapheme-boundary offsets of a string.
+func byteToClusterIndex(offs []int, byteOff int) int {
+	return sort.SearchInts(offs, byteOff)
+}
@@ -0,0 +1,130 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import (
+	"regexp"
+	"testing"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		s       string
+		want    bool
+	}{
+		{"star all", "*", "hello", true},
+		{"literal", "hello", "hello", true},
+		{"literal mismatch", "hello", "world", false},
+		{"question single cluster", "h?llo", "hello", true},
+		{"question does not span cluster", "?", "世", true},
+		{"question does not match two clusters", "??", "世", false},
+		{"star prefix suffix", "h*o", "hello", true},
+		{"class", "[abc]bc", "abc", true},
+		{"negated class", "[^abc]bc", "xbc", true},
+		{"negated class mismatch", "[^abc]bc", "abc", false},
+		{"range class", "[a-z]bc", "abc", true},
+		{"emoji literal", "👋", "👋", true},
+		{"star with emoji", "*👋", "hi👋", true},
+		{"question over flag cluster", "?", "🇺🇸", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Match(tt.pattern, tt.s)
+			if err != nil {
+				t.Fatalf("Match(%q, %q) error: %v", tt.pattern, tt.s, err)
+			}
+			if got != tt.want {
+				t.Errorf("Match(%q, %q) = %v, want %v", tt.pattern, tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		s       string
+		want    bool
+	}{
+		{"lone star stays within a segment", "a/*/c", "a/b/c", true},
+		{"lone star does not cross a segment", "a/*/c", "a/b/x/c", false},
+		{"doublestar still needs its flanking separators", "a/**/c", "a/c", false},
+		{"doublestar spans one segment", "a/**/c", "a/b/c", true},
+		{"doublestar spans many segments", "a/**/c", "a/b/x/y/c", true},
+		{"doublestar at start", "**/c", "a/b/c", true},
+		{"doublestar at end", "a/**", "a/b/c", true},
+		{"literal segment must still match", "a/**/c", "a/b/x/d", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MatchPath(tt.pattern, tt.s, "/")
+			if err != nil {
+				t.Fatalf("MatchPath(%q, %q) error: %v", tt.pattern, tt.s, err)
+			}
+			if got != tt.want {
+				t.Errorf("MatchPath(%q, %q) = %v, want %v", tt.pattern, tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchPathEmptySepFallsBackToMatch(t *testing.T) {
+	got, err := MatchPath("a/**/c", "a/b/c", "")
+	if err != nil {
+		t.Fatalf("MatchPath error: %v", err)
+	}
+	if !got {
+		t.Error("MatchPath with sep=\"\" should treat ** as * and still match a single segment")
+	}
+}
+
+func TestMatchPathFold(t *testing.T) {
+	got, err := MatchPathFold("A/**/C", "a/b/c", "/")
+	if err != nil {
+		t.Fatalf("MatchPathFold error: %v", err)
+	}
+	if !got {
+		t.Error("MatchPathFold should match under case folding")
+	}
+}
+
+func TestMatchFold(t *testing.T) {
+	got, err := MatchFold("HÉLLO", "héllo")
+	if err != nil {
+		t.Fatalf("MatchFold error: %v", err)
+	}
+	if !got {
+		t.Error("MatchFold should match under case folding")
+	}
+}
+
+func TestMatchUnterminatedClass(t *testing.T) {
+	if _, err := Match("[abc", "a"); err == nil {
+		t.Error("expected error for unterminated character class")
+	}
+}
+
+func TestRegexpFind(t *testing.T) {
+	re := regexp.MustCompile(`世界`)
+	match, cs, ce, found := RegexpFind(re, "Hi世界!")
+	if !found || match != "世界" {
+		t.Fatalf("RegexpFind = (%q, found=%v)", match, found)
+	}
+	if cs != 2 || ce != 4 {
+		t.Errorf("RegexpFind cluster bounds = (%d, %d), want (2, 4)", cs, ce)
+	}
+}
+
+func TestRegexpReplace(t *testing.T) {
+	re := regexp.MustCompile(`\d+`)
+	got := RegexpReplace(re, "a1b22c", "#")
+	if got != "a#b#c" {
+		t.Errorf("RegexpReplace = %q, want a#b#c", got)
+	}
+}
@@ -0,0 +1,206 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import (
+	"sort"
+	"unicode/utf8"
+)
+
+// acNode is a node in the Aho-Corasick trie underlying Matcher, keyed by
+// rune rather than byte so multi-byte patterns match in a single trie
+// step per rune.
+type acNode struct {
+	children map[rune]*acNode
+	fail     *acNode
+	output   []int // indices into Matcher.patterns ending at this node
+}
+
+// MatchResult reports one occurrence found by a Matcher.
+type MatchResult struct {
+	Pattern                  string
+	ByteStart, ByteEnd       int
+	ClusterStart, ClusterEnd int
+}
+
+// Matcher finds all occurrences of a fixed set of patterns in a string
+// in O(len(s) + matches) time via the Aho-Corasick algorithm, rather
+// than the O(N*len(s)) that N repeated IndexSubstring calls would cost.
+type Matcher struct {
+	root     *acNode
+	patterns []string
+	fold     bool
+}
+
+// NewMatcher builds a Matcher for the given patterns.
+func NewMatcher(patterns []string) *Matcher {
+	return buildMatcher(patterns, false)
+}
+
+// NewMatcherFold builds a case-folded Matcher: patterns and the search
+// text are compared rune by rune under simple Unicode case folding, so
+// one-to-many folds such as "ß" and "ss" do not match each other.
+func NewMatcherFold(patterns []string) *Matcher {
+	return buildMatcher(patterns, true)
+}
+
+func buildMatcher(patterns []string, fold bool) *Matcher {
+	root := &acNode{children: make(map[rune]*acNode)}
+	for idx, p := range patterns {
+		n := root
+		for _, r := range p {
+			if fold {
+				r = foldKey(r)
+			}
+			child, ok := n.children[r]
+			if !ok {
+				child = &acNode{children: make(map[rune]*acNode)}
+				n.children[r] = child
+			}
+			n = child
+		}
+		n.output = append(n.output, idx)
+	}
+
+	var queue []*acNode
+	for _, child := range root.children {
+		child.fail = root
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		for r, child := range n.children {
+			queue = append(queue, child)
+			f := n.fail
+			for f != nil {
+				if fc, ok := f.children[r]; ok {
+					child.fail = fc
+					break
+				}
+				f = f.fail
+			}
+			if child.fail == nil {
+				child.fail = root
+			}
+			child.output = append(child.output, child.fail.output...)
+		}
+	}
+	return &Matcher{root: root, patterns: patterns, fold: fold}
+}
+
+// FindAllOverlapping returns every occurrence of every pattern in s,
+// including overlapping ones, ordered by byte start position and, for
+// matches starting at the same position, by ascending pattern length.
+// A candidate match that does not begin and end on a grapheme-cluster
+// boundary is discarded, so patterns never match partway into a
+// multi-rune cluster such as a ZWJ sequence.
+func (m *Matcher) FindAllOverlapping(s string) []MatchResult {
+	offs := graphemeBoundaries(s)
+	atBoundary := make(map[int]bool, len(offs))
+	for _, o := range offs {
+		atBoundary[o] = true
+	}
+
+	var raw []MatchResult
+	n := m.root
+	for i, r := range s {
+		key := r
+		if m.fold {
+			key = foldKey(r)
+		}
+		for n != m.root {
+			if _, ok := n.children[key]; ok {
+				break
+			}
+			n = n.fail
+		}
+		if child, ok := n.children[key]; ok {
+			n = child
+		} else {
+			n = m.root
+		}
+		// range's index i is the byte offset of r itself; use i plus r's
+		// own encoded length (not len(string(r)), which for an invalid
+		// sequence is U+FFFD's 3-byte encoding rather than the 1 byte the
+		// range loop actually consumed) to stay in sync on invalid UTF-8.
+		end := i + utf8.RuneLen(r)
+		if r == utf8.RuneError {
+			_, size := utf8.DecodeRuneInString(s[i:])
+			end = i + size
+		}
+		for _, idx := range n.output {
+			p := m.patterns[idx]
+			start := end - len(p)
+			if atBoundary[start] && atBoundary[end] {
+				raw = append(raw, MatchResult{Pattern: p, ByteStart: start, ByteEnd: end})
+			}
+		}
+	}
+	sort.Slice(raw, func(i, j int) bool {
+		if raw[i].ByteStart != raw[j].ByteStart {
+			return raw[i].ByteStart < raw[j].ByteStart
+		}
+		return raw[i].ByteEnd < raw[j].ByteEnd
+	})
+	m.fillClusterBoundsFrom(offs, raw)
+	return raw
+}
+
+// FindAll returns non-overlapping matches of the patterns in s, scanning
+// left to right: the match with the leftmost start is taken and, among
+// the matches starting there, the longest one; matches overlapping it
+// are skipped.
+func (m *Matcher) FindAll(s string) []MatchResult {
+	all := m.FindAllOverlapping(s)
+	var out []MatchResult
+	next := 0
+	for i := 0; i < len(all); i++ {
+		if all[i].ByteStart < next {
+			continue
+		}
+		best := all[i]
+		for j := i + 1; j < len(all) && all[j].ByteStart == all[i].ByteStart; j++ {
+			if all[j].ByteEnd > best.ByteEnd {
+				best = all[j]
+			}
+		}
+		out = append(out, best)
+		next = best.ByteEnd
+	}
+	return out
+}
+
+// ReplaceAll returns s with every non-overlapping match found by FindAll
+// replaced according to repl, keyed by pattern. A matched pattern absent
+// from repl is left unchanged.
+func (m *Matcher) ReplaceAll(s string, repl map[string]string) string {
+	matches := m.FindAll(s)
+	if len(matches) == 0 {
+		return s
+	}
+	var b []byte
+	last := 0
+	for _, mt := range matches {
+		b = append(b, s[last:mt.ByteStart]...)
+		if r, ok := repl[mt.Pattern]; ok {
+			b = append(b, r...)
+		} else {
+			b = append(b, s[mt.ByteStart:mt.ByteEnd]...)
+		}
+		last = mt.ByteEnd
+	}
+	b = append(b, s[last:]...)
+	return string(b)
+}
+
+// fillClusterBoundsFrom maps each match's byte offsets to grapheme-cluster
+// indices in place, using the grapheme boundaries of the string the
+// matches were found in.
+func (m *Matcher) fillClusterBoundsFrom(offs []int, matches []MatchResult) {
+	for i := range matches {
+		matches[i].ClusterStart = byteToClusterIndex(offs, matches[i].ByteStart)
+		matches[i].ClusterEnd = byteToClusterIndex(offs, matches[i].ByteEnd)
+	}
+}
@@ -0,0 +1,110 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMatcherFindAll(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		s        string
+		want     []string
+	}{
+		{"ascii patterns", []string{"cat", "dog"}, "a cat and a dog", []string{"cat", "dog"}},
+		{"longest match wins at a position", []string{"ab", "a"}, "abc", []string{"ab"}},
+		{"overlapping candidates resolve leftmost first", []string{"he", "she", "hers"}, "ushers", []string{"she"}},
+		{"no match", []string{"x", "y"}, "hello", nil},
+		{"grapheme clusters", []string{"👋", "世界"}, "👋 世界", []string{"👋", "世界"}},
+		{"does not split zwj cluster", []string{"👨"}, "👨‍👩‍👧", nil},
+		{"empty matcher", nil, "hello", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMatcher(tt.patterns)
+			matches := m.FindAll(tt.s)
+			var got []string
+			for _, mt := range matches {
+				got = append(got, mt.Pattern)
+			}
+			if !equalStrings(got, tt.want) {
+				t.Errorf("FindAll(%q) = %v, want %v", tt.s, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatcherFindAllClusterBounds(t *testing.T) {
+	m := NewMatcher([]string{"世界"})
+	matches := m.FindAll("👋 世界")
+	if len(matches) != 1 {
+		t.Fatalf("FindAll returned %d matches, want 1", len(matches))
+	}
+	got := matches[0]
+	if got.ClusterStart != 2 || got.ClusterEnd != 4 {
+		t.Errorf("cluster bounds = [%d, %d), want [2, 4)", got.ClusterStart, got.ClusterEnd)
+	}
+}
+
+func TestMatcherFindAllOverlapping(t *testing.T) {
+	m := NewMatcher([]string{"he", "she", "hers"})
+	matches := m.FindAllOverlapping("she")
+	var got []string
+	for _, mt := range matches {
+		got = append(got, mt.Pattern)
+	}
+	want := []string{"she", "he"}
+	if !equalStrings(got, want) {
+		t.Errorf("FindAllOverlapping(%q) = %v, want %v", "she", got, want)
+	}
+}
+
+func TestMatcherFindAllOverlappingInvalidUTF8(t *testing.T) {
+	// An invalid byte decodes as a single RuneError rune when ranged over,
+	// but RuneError's own 3-byte UTF-8 encoding is not the 1 byte the
+	// range loop actually consumed - byte offsets must track the range
+	// index, not accumulate len(string(r)), or later matches desync.
+	s := "a" + string([]byte{0xff}) + "b"
+	m := NewMatcher([]string{"b"})
+	matches := m.FindAllOverlapping(s)
+	if len(matches) != 1 {
+		t.Fatalf("FindAllOverlapping(%q) = %v, want one match for \"b\"", s, matches)
+	}
+	if matches[0].ByteStart != 2 || matches[0].ByteEnd != 3 {
+		t.Errorf("FindAllOverlapping(%q) match = %+v, want ByteStart=2 ByteEnd=3", s, matches[0])
+	}
+}
+
+func TestMatcherFold(t *testing.T) {
+	m := NewMatcherFold([]string{"HÉLLO"})
+	matches := m.FindAll("say héllo now")
+	if len(matches) != 1 || matches[0].Pattern != "HÉLLO" {
+		t.Errorf("FindAll under folding = %v, want one match for %q", matches, "HÉLLO")
+	}
+}
+
+func TestMatcherReplaceAll(t *testing.T) {
+	m := NewMatcher([]string{"👋", "世界"})
+	got := m.ReplaceAll("👋 世界", map[string]string{"👋": "wave", "世界": "world"})
+	want := "wave world"
+	if got != want {
+		t.Errorf("ReplaceAll = %q, want %q", got, want)
+	}
+}
+
+func TestMatcherReplaceAllNoMatch(t *testing.T) {
+	m := NewMatcher([]string{"x"})
+	s := "hello"
+	if got := m.ReplaceAll(s, nil); got != s {
+		t.Errorf("ReplaceAll(%q) = %q, want unchanged", s, got)
+	}
+}
+
+func equalStrings(a, b []string) bool {
+	return strings.Join(a, "\x00") == strings.Join(b, "\x00")
+}
@@ -0,0 +1,78 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import "golang.org/x/text/unicode/norm"
+
+// NormForm identifies one of the four standard Unicode normalization
+// forms used by Normalize, IsNormalized, EqualNorm and EqualFoldNorm.
+type NormForm int
+
+const (
+	// NormNFC composes decomposed sequences back into precomposed
+	// characters where the Unicode Character Database defines one -
+	// for example "e" + COMBINING ACUTE ACCENT becomes "é" (U+00E9).
+	NormNFC NormForm = iota
+	// NormNFD fully decomposes characters into base + combining marks,
+	// the reverse of NormNFC.
+	NormNFD
+	// NormNFKC is like NormNFC but also applies compatibility mappings,
+	// so for example the ligature "ﬁ" becomes "fi".
+	NormNFKC
+	// NormNFKD is like NormNFD but also applies compatibility mappings.
+	NormNFKD
+)
+
+// form maps a NormForm to the underlying x/text/unicode/norm.Form.
+func (f NormForm) form() norm.Form {
+	switch f {
+	case NormNFD:
+		return norm.NFD
+	case NormNFKC:
+		return norm.NFKC
+	case NormNFKD:
+		return norm.NFKD
+	default:
+		return norm.NFC
+	}
+}
+
+// Normalize returns s converted to the given Unicode normalization form.
+//
+// Normalization can change the number of graphemes in a string: NormNFKD
+// turns the single-cluster ligature "ﬁ" into the two clusters "f" and
+// "i". Even where the count stays the same, the byte length can change:
+// the two codepoints "e" + COMBINING ACUTE ACCENT are a single grapheme cluster
+// both before and after Normalize(s, NormNFC), but a string built from
+// precomposed "é" has a different byte length, and therefore different
+// Offset/At results, than its NFD equivalent even though Len agrees.
+// Callers comparing strings from different sources (for example a
+// filename from one OS and user input from another) should normalize
+// both to the same form before relying on byte-wise operations.
+func Normalize(s string, form NormForm) string {
+	return form.form().String(s)
+}
+
+// IsNormalized reports whether s is already in the given normalization
+// form, without allocating a normalized copy. It's a fast path for
+// callers who only need to validate input, such as rejecting
+// non-normalized filenames before they're persisted.
+func IsNormalized(s string, form NormForm) bool {
+	return form.form().IsNormalString(s)
+}
+
+// EqualNorm reports whether a and b are equal after both are normalized
+// to form, so "é" (precomposed) and "e"+COMBINING ACUTE ACCENT
+// (decomposed) compare equal under NormNFC or NormNFKC.
+func EqualNorm(a, b string, form NormForm) bool {
+	return Normalize(a, form) == Normalize(b, form)
+}
+
+// EqualFoldNorm reports whether a and b are equal under full Unicode
+// case-folding once both are normalized to form, combining EqualFold
+// with EqualNorm for inputs that may differ in both case and
+// normalization form.
+func EqualFoldNorm(a, b string, form NormForm) bool {
+	return EqualFold(Normalize(a, form), Normalize(b, form))
+}
@@ -0,0 +1,88 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import "testing"
+
+// A small hand-picked sample of the Unicode normalization conformance
+// data (NormalizationTest.txt part 1), covering precomposed Latin,
+// Hangul, and a compatibility ligature. Codepoints are written as \u
+// escapes so the precomposed and decomposed forms can't be silently
+// collapsed into each other by an editor or formatter.
+const (
+	eAcutePrecomposed = "\u00e9"        // LATIN SMALL LETTER E WITH ACUTE
+	eAcuteDecomposed  = "e\u0301"       // LATIN SMALL LETTER E, COMBINING ACUTE ACCENT
+	angstromSign      = "\u212b"        // ANGSTROM SIGN
+	aRingPrecomposed  = "\u00c5"        // LATIN CAPITAL LETTER A WITH RING ABOVE
+	fiLigature        = "\ufb01"        // LATIN SMALL LIGATURE FI
+	fiExpanded        = "fi"
+	hangulPrecomposed = "\uac00"        // HANGUL SYLLABLE GA
+	hangulDecomposed  = "\u1100\u1161" // HANGUL CHOSEONG KIYEOK, JUNGSEONG A
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		form NormForm
+		want string
+	}{
+		{"NFC composes combining acute", eAcuteDecomposed, NormNFC, eAcutePrecomposed},
+		{"NFD decomposes precomposed acute", eAcutePrecomposed, NormNFD, eAcuteDecomposed},
+		{"NFC composes Angstrom sign to the A-ring letter", angstromSign, NormNFC, aRingPrecomposed},
+		{"NFKC folds ligature to fi", fiLigature, NormNFKC, fiExpanded},
+		{"NFC leaves ligature untouched", fiLigature, NormNFC, fiLigature},
+		{"NFC composes Hangul jamo", hangulDecomposed, NormNFC, hangulPrecomposed},
+		{"NFKD decomposes Hangul", hangulPrecomposed, NormNFKD, hangulDecomposed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Normalize(tt.s, tt.form); got != tt.want {
+				t.Errorf("Normalize(%q, %v) = %q, want %q", tt.s, tt.form, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsNormalized(t *testing.T) {
+	if !IsNormalized(eAcuteDecomposed, NormNFD) {
+		t.Error("decomposed e+acute should already be NFD")
+	}
+	if IsNormalized(eAcuteDecomposed, NormNFC) {
+		t.Error("decomposed e+acute should not be NFC")
+	}
+	if !IsNormalized(eAcutePrecomposed, NormNFC) {
+		t.Error("precomposed e-acute should already be NFC")
+	}
+}
+
+func TestEqualNorm(t *testing.T) {
+	if !EqualNorm(eAcutePrecomposed, eAcuteDecomposed, NormNFC) {
+		t.Error("precomposed and decomposed e-acute should be EqualNorm under NFC")
+	}
+	if EqualNorm(eAcutePrecomposed, "e", NormNFC) {
+		t.Error("e-acute and e should not be EqualNorm")
+	}
+}
+
+func TestEqualFoldNorm(t *testing.T) {
+	const upperPrecomposed = "\u00c9" // LATIN CAPITAL LETTER E WITH ACUTE
+	if !EqualFoldNorm(upperPrecomposed, eAcuteDecomposed, NormNFC) {
+		t.Error("precomposed upper e-acute and decomposed lower e-acute should be EqualFoldNorm under NFC")
+	}
+}
+
+func TestNormalizeShiftsByteLengthNotGraphemeCount(t *testing.T) {
+	if Len(eAcuteDecomposed) != 1 {
+		t.Fatalf("Len(%q) = %d, want 1 (combining mark joins its base into one grapheme)", eAcuteDecomposed, Len(eAcuteDecomposed))
+	}
+	composed := Normalize(eAcuteDecomposed, NormNFC)
+	if len(composed) == len(eAcuteDecomposed) {
+		t.Error("NFC composition should shrink the byte length of a decomposed sequence")
+	}
+	if Len(composed) != 1 {
+		t.Errorf("Len(%q) = %d, want 1", composed, Len(composed))
+	}
+}
@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import "strings"
+
+// Map returns a copy of s with each grapheme cluster replaced by
+// mapping(cluster). A mapping that returns "" drops the cluster
+// entirely. Unlike strings.Map, which rewrites one rune at a time, this
+// gives the callback a whole cluster at a time, which is what's needed
+// to strip an emoji, normalize a skin-tone modifier, or transliterate a
+// cluster as a unit.
+func Map(mapping func(g string) string, s string) string {
+	var b strings.Builder
+	it := Graphemes(s)
+	for {
+		g := it.Next()
+		if g == "" {
+			break
+		}
+		b.WriteString(mapping(g))
+	}
+	return b.String()
+}
+
+// Cut slices s around the first instance of sep, matched only at
+// grapheme-cluster boundaries. It returns the text before and after sep
+// and a bool reporting whether sep was found. If sep is not found, Cut
+// returns s, "", false.
+func Cut(s, sep string) (before, after string, found bool) {
+	if sep == "" {
+		return "", s, true
+	}
+	offs := graphemeBoundaries(s)
+	atBoundary := make(map[int]bool, len(offs))
+	for _, o := range offs {
+		atBoundary[o] = true
+	}
+	i := nextBoundaryMatch(s, sep, 0, atBoundary)
+	if i < 0 {
+		return s, "", false
+	}
+	return s[:i], s[i+len(sep):], true
+}
+
+// CutPrefix returns s without the provided leading prefix, matched only
+// at a grapheme-cluster boundary, and reports whether it found the
+// prefix. If s doesn't start with prefix, CutPrefix returns s, false.
+func CutPrefix(s, prefix string) (after string, found bool) {
+	if !HasPrefix(s, prefix) {
+		return s, false
+	}
+	return s[len(prefix):], true
+}
+
+// CutSuffix returns s without the provided trailing suffix, matched only
+// at a grapheme-cluster boundary, and reports whether it found the
+// suffix. If s doesn't end with suffix, CutSuffix returns s, false.
+func CutSuffix(s, suffix string) (before string, found bool) {
+	if !HasSuffix(s, suffix) {
+		return s, false
+	}
+	return s[:len(s)-len(suffix)], true
+}
@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	dropEmoji := func(g string) string {
+		if Width(g) == 2 && len(g) > 1 {
+			return ""
+		}
+		return g
+	}
+	got := Map(dropEmoji, "Hi👋there世")
+	if got != "Hithere" {
+		t.Errorf("Map = %q, want %q", got, "Hithere")
+	}
+
+	upper := func(g string) string { return strings.ToUpper(g) }
+	if got := Map(upper, "abc"); got != "ABC" {
+		t.Errorf("Map upper = %q, want ABC", got)
+	}
+}
+
+func TestCut(t *testing.T) {
+	tests := []struct {
+		name   string
+		s, sep string
+		before string
+		after  string
+		found  bool
+	}{
+		{"found", "hello=world", "=", "hello", "world", true},
+		{"not found", "hello", "=", "hello", "", false},
+		{"zwj boundary not split", "a👨‍👩‍👧b", "‍", "a👨‍👩‍👧b", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			before, after, found := Cut(tt.s, tt.sep)
+			if before != tt.before || after != tt.after || found != tt.found {
+				t.Errorf("Cut(%q, %q) = (%q, %q, %v), want (%q, %q, %v)",
+					tt.s, tt.sep, before, after, found, tt.before, tt.after, tt.found)
+			}
+		})
+	}
+}
+
+func TestCutPrefixSuffix(t *testing.T) {
+	after, ok := CutPrefix("👨‍👩‍👧 family", "👨‍👩‍👧")
+	if !ok || after != " family" {
+		t.Errorf("CutPrefix = (%q, %v)", after, ok)
+	}
+	if _, ok := CutPrefix("hello", "world"); ok {
+		t.Error("CutPrefix should not find world in hello")
+	}
+
+	before, ok := CutSuffix("世界hello", "hello")
+	if !ok || before != "世界" {
+		t.Errorf("CutSuffix = (%q, %v)", before, ok)
+	}
+}
+
+func TestBuilder(t *testing.T) {
+	var b Builder
+	b.WriteString("Hi世界👋")
+	if b.Len() != 5 {
+		t.Errorf("Len() = %d, want 5", b.Len())
+	}
+	if got, want := b.String(), "Hi世界👋"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	wantWidth := Width("Hi世界👋")
+	if b.Width() != wantWidth {
+		t.Errorf("Width() = %d, want %d", b.Width(), wantWidth)
+	}
+
+	b.TruncateToWidth(4)
+	if b.Width() > 4 {
+		t.Errorf("after TruncateToWidth(4), Width() = %d, want <= 4", b.Width())
+	}
+	// Truncation must land on a whole-grapheme boundary.
+	if !Valid(b.String()) {
+		t.Errorf("TruncateToWidth produced invalid UTF-8: %q", b.String())
+	}
+}
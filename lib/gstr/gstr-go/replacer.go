@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import "io"
+
+// replacerNode is a node in the byte-trie underlying Replacer.
+type replacerNode struct {
+	children map[byte]*replacerNode
+	// hasValue is true if a pattern ends at this node.
+	hasValue bool
+	value    string
+}
+
+// Replacer replaces a list of old, new string pairs in a single pass,
+// never matching inside a grapheme cluster. It is safe for concurrent
+// use by multiple goroutines.
+type Replacer struct {
+	root *replacerNode
+}
+
+// NewReplacer returns a new Replacer from a list of old, new string
+// pairs. Replacements are performed in the order they appear in the
+// target string, without overlapping matches. At a given position, the
+// longest matching old string is used; ties are broken in favor of the
+// pair registered earliest in pairs.
+//
+// NewReplacer panics if given an odd number of arguments.
+func NewReplacer(pairs ...string) *Replacer {
+	if len(pairs)%2 != 0 {
+		panic("gstr.NewReplacer: odd argument count")
+	}
+	root := &replacerNode{}
+	for i := 0; i < len(pairs); i += 2 {
+		old, new := pairs[i], pairs[i+1]
+		n := root
+		for j := 0; j < len(old); j++ {
+			b := old[j]
+			if n.children == nil {
+				n.children = make(map[byte]*replacerNode)
+			}
+			child, ok := n.children[b]
+			if !ok {
+				child = &replacerNode{}
+				n.children[b] = child
+			}
+			n = child
+		}
+		if !n.hasValue {
+			n.hasValue = true
+			n.value = new
+		}
+	}
+	return &Replacer{root: root}
+}
+
+// replacerCandidate is one registered pattern matching at a position,
+// together with its byte length.
+type replacerCandidate struct {
+	value  string
+	length int
+}
+
+// candidates returns every registered pattern matching s[pos:], ordered
+// longest-first. Replace tries them in this order so that a longer
+// match rejected for not landing on a grapheme-cluster boundary doesn't
+// stop a shorter, boundary-respecting match at the same position from
+// being tried.
+func (r *Replacer) candidates(s string, pos int) []replacerCandidate {
+	var out []replacerCandidate
+	node := r.root
+	for i := pos; i < len(s); i++ {
+		child, exists := node.children[s[i]]
+		if !exists {
+			break
+		}
+		node = child
+		if node.hasValue {
+			out = append(out, replacerCandidate{value: node.value, length: i - pos + 1})
+		}
+	}
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return out
+}
+
+// Replace returns a copy of s with all registered replacements applied,
+// left to right, skipping any candidate match that does not begin and
+// end on a grapheme-cluster boundary. At each position, candidates are
+// tried longest to shortest until one respects both boundaries.
+func (r *Replacer) Replace(s string) string {
+	if r.root.children == nil {
+		return s
+	}
+	boundaries := graphemeBoundaries(s)
+	atBoundary := make(map[int]bool, len(boundaries))
+	for _, o := range boundaries {
+		atBoundary[o] = true
+	}
+
+	var b []byte
+	last := 0
+	for i := 0; i < len(s); {
+		if !atBoundary[i] {
+			i++
+			continue
+		}
+		matched := false
+		for _, c := range r.candidates(s, i) {
+			if atBoundary[i+c.length] {
+				b = append(b, s[last:i]...)
+				b = append(b, c.value...)
+				i += c.length
+				last = i
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			i++
+		}
+	}
+	b = append(b, s[last:]...)
+	return string(b)
+}
+
+// WriteString writes the result of replacing s to w, returning the
+// number of bytes written and any write error.
+func (r *Replacer) WriteString(w io.Writer, s string) (int, error) {
+	return io.WriteString(w, r.Replace(s))
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestReplacer(t *testing.T) {
+	tests := []struct {
+		name  string
+		pairs []string
+		input string
+		want  string
+	}{
+		{"ascii pairs", []string{"a", "1", "b", "2"}, "abc", "12c"},
+		{"emoji and word", []string{"👋", "wave", "世界", "world"}, "👋 世界", "wave world"},
+		{"longest match wins", []string{"ab", "X", "a", "Y"}, "abc", "Xc"},
+		{"earliest registered wins tie", []string{"ab", "X", "ab", "Y"}, "ab", "X"},
+		{"no match", []string{"x", "y"}, "hello", "hello"},
+		{"does not split zwj cluster", []string{"👨", "Y"}, "👨‍👩‍👧", "👨‍👩‍👧"},
+		{"empty replacer", nil, "hello", "hello"},
+		{
+			"falls back to shorter match when longest crosses a boundary",
+			[]string{"é", "Y", "éb", "Z"},
+			"éb̂",
+			"Yb̂",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := NewReplacer(tt.pairs...)
+			got := r.Replace(tt.input)
+			if got != tt.want {
+				t.Errorf("Replace(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReplacerWriteString(t *testing.T) {
+	r := NewReplacer("foo", "bar")
+	var b strings.Builder
+	n, err := r.WriteString(&b, "foofoo")
+	if err != nil {
+		t.Fatalf("WriteString error: %v", err)
+	}
+	if b.String() != "barbar" {
+		t.Errorf("WriteString result = %q, want %q", b.String(), "barbar")
+	}
+	if n != len("barbar") {
+		t.Errorf("WriteString n = %d, want %d", n, len("barbar"))
+	}
+}
+
+func TestNewReplacerOddArgsPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Fatal("expected panic for odd argument count")
+		}
+	}()
+	NewReplacer("a")
+}
+
+func BenchmarkReplacerSinglePass(b *testing.B) {
+	r := NewReplacer("👋", "wave", "世界", "world", "foo", "bar")
+	s := strings.Repeat("foo 👋 世界 ", 100)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		r.Replace(s)
+	}
+}
+
+func BenchmarkReplaceNaiveLoop(b *testing.B) {
+	s := strings.Repeat("foo 👋 世界 ", 100)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		out := Replace(s, "👋", "wave")
+		out = Replace(out, "世界", "world")
+		out = Replace(out, "foo", "bar")
+		_ = out
+	}
+}
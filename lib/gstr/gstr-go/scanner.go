@@ -0,0 +1,211 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import (
+	"errors"
+	"io"
+	"unicode/utf8"
+)
+
+// errScannerBufferFull is returned by GraphemeScanner when the internal
+// buffer reaches its configured maximum without ever disambiguating a
+// grapheme cluster, which would otherwise grow without bound.
+var errScannerBufferFull = errors.New("gstr: grapheme scanner buffer full")
+
+const defaultScannerMaxBuf = 1 << 20 // 1 MiB
+
+// GraphemeScanner reads UTF-8 text from an io.Reader and segments it
+// into grapheme clusters incrementally, in the style of bufio.Scanner.
+// It correctly buffers an incomplete cluster across Read boundaries -
+// for example an unpaired regional indicator, a ZWJ awaiting its next
+// base, a trailing combining mark, or an unfinished UTF-8 sequence -
+// and only emits it once later data (or EOF) disambiguates it.
+type GraphemeScanner struct {
+	r     io.Reader
+	buf   []byte
+	start int
+	max   int
+	eof   bool
+	err   error
+	token []byte
+}
+
+// NewGraphemeScanner returns a GraphemeScanner that reads from r.
+func NewGraphemeScanner(r io.Reader) *GraphemeScanner {
+	return &GraphemeScanner{r: r, buf: make([]byte, 0, 4096), max: defaultScannerMaxBuf}
+}
+
+// Buffer sets the initial buffer to use for scanning and the maximum
+// size it may grow to accommodate a single undisambiguated cluster.
+func (s *GraphemeScanner) Buffer(buf []byte, max int) {
+	s.buf = buf[:0]
+	s.max = max
+}
+
+// Scan advances the scanner to the next grapheme cluster, which will
+// then be available through Text or Bytes. It returns false when the
+// scan stops, either by reaching the end of the input or an error.
+func (s *GraphemeScanner) Scan() bool {
+	if s.err != nil {
+		return false
+	}
+	for {
+		if tok, n, ok := nextSafeCluster(s.buf[s.start:], s.eof); ok {
+			s.token = append(s.token[:0], tok...)
+			s.start += n
+			return true
+		}
+		if s.eof {
+			return false
+		}
+		if err := s.fill(); err != nil {
+			s.err = err
+			return false
+		}
+	}
+}
+
+// fill reads more data into the buffer, compacting already-consumed
+// bytes and growing the buffer (up to max) as needed.
+func (s *GraphemeScanner) fill() error {
+	if s.start > 0 {
+		s.buf = append(s.buf[:0], s.buf[s.start:]...)
+		s.start = 0
+	}
+	if len(s.buf) >= s.max {
+		return errScannerBufferFull
+	}
+	if cap(s.buf) == len(s.buf) {
+		newCap := cap(s.buf) * 2
+		if newCap == 0 {
+			newCap = 4096
+		}
+		if newCap > s.max {
+			newCap = s.max
+		}
+		nb := make([]byte, len(s.buf), newCap)
+		copy(nb, s.buf)
+		s.buf = nb
+	}
+	n, err := s.r.Read(s.buf[len(s.buf):cap(s.buf)])
+	s.buf = s.buf[:len(s.buf)+n]
+	if err != nil {
+		if err == io.EOF {
+			s.eof = true
+			return nil
+		}
+		return err
+	}
+	if n == 0 {
+		s.eof = true
+	}
+	return nil
+}
+
+// Text returns the most recent grapheme cluster generated by a call to
+// Scan as a string.
+func (s *GraphemeScanner) Text() string {
+	return string(s.token)
+}
+
+// Bytes returns the most recent grapheme cluster generated by a call to
+// Scan. The underlying array may point into data that will be
+// overwritten by a subsequent call to Scan.
+func (s *GraphemeScanner) Bytes() []byte {
+	return s.token
+}
+
+// Err returns the first non-EOF error encountered while scanning.
+func (s *GraphemeScanner) Err() error {
+	if s.err == io.EOF {
+		return nil
+	}
+	return s.err
+}
+
+// nextSafeCluster returns the first grapheme cluster in data that is
+// safe to emit. Unless eof is true, a single trailing cluster is held
+// back in case more data would extend it (an unfinished UTF-8 sequence,
+// an unpaired regional indicator, a dangling ZWJ, or a trailing
+// extending/spacing mark) - it is only emitted once a second cluster
+// proves the boundary is final. Before even attempting segmentation, it
+// also holds back if data itself ends mid-codepoint - otherwise a
+// multi-byte rune split across Read calls would have its stray leading
+// bytes decoded as their own invalid-UTF-8 "cluster", which would
+// satisfy the two-cluster check and emit corrupt fragments.
+func nextSafeCluster(data []byte, eof bool) (cluster []byte, n int, ok bool) {
+	if len(data) == 0 {
+		return nil, 0, false
+	}
+	if !eof && incompleteTrailingRune(data) {
+		return nil, 0, false
+	}
+	it := Graphemes(string(data))
+	g1 := it.Next()
+	if g1 == "" {
+		return nil, 0, false
+	}
+	if eof {
+		return []byte(g1), len(g1), true
+	}
+	if g2 := it.Next(); g2 != "" {
+		return []byte(g1), len(g1), true
+	}
+	return nil, 0, false
+}
+
+// incompleteTrailingRune reports whether data ends with the leading
+// bytes of a multi-byte UTF-8 sequence that hasn't fully arrived yet -
+// as opposed to data simply ending with invalid UTF-8 that more bytes
+// couldn't fix.
+func incompleteTrailingRune(data []byte) bool {
+	n := len(data)
+	limit := utf8.UTFMax
+	if n < limit {
+		limit = n
+	}
+	for i := 1; i <= limit; i++ {
+		b := data[n-i]
+		if utf8.RuneStart(b) {
+			return !utf8.FullRune(data[n-i:])
+		}
+	}
+	return false
+}
+
+// graphemeReader adapts a GraphemeScanner into an io.Reader that
+// guarantees every Read returns whole grapheme clusters.
+type graphemeReader struct {
+	sc      *GraphemeScanner
+	pending []byte
+}
+
+// NewGraphemeReader returns an io.Reader over r that guarantees each
+// Read returns whole grapheme clusters, never splitting one across
+// calls - useful for piping text through width-limited terminals.
+func NewGraphemeReader(r io.Reader) io.Reader {
+	return &graphemeReader{sc: NewGraphemeScanner(r)}
+}
+
+func (g *graphemeReader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if len(g.pending) == 0 {
+		if !g.sc.Scan() {
+			if err := g.sc.Err(); err != nil {
+				return 0, err
+			}
+			return 0, io.EOF
+		}
+		g.pending = append(g.pending, g.sc.Bytes()...)
+	}
+	if len(g.pending) > len(p) {
+		return 0, io.ErrShortBuffer
+	}
+	n := copy(p, g.pending)
+	g.pending = g.pending[n:]
+	return n, nil
+}
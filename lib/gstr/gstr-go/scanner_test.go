@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+func scanAll(t *testing.T, sc *GraphemeScanner) []string {
+	t.Helper()
+	var got []string
+	for sc.Scan() {
+		got = append(got, sc.Text())
+	}
+	if err := sc.Err(); err != nil {
+		t.Fatalf("Scan error: %v", err)
+	}
+	return got
+}
+
+func TestGraphemeScanner(t *testing.T) {
+	got := scanAll(t, NewGraphemeScanner(strings.NewReader("Hi世界👋")))
+	want := []string{"H", "i", "世", "界", "👋"}
+	if len(got) != len(want) {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("cluster %d = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
+
+// chunkedReader splits a string into reads of a fixed size, simulating
+// a stream that can split a grapheme cluster across Read boundaries.
+type chunkedReader struct {
+	data string
+	pos  int
+	size int
+}
+
+func (c *chunkedReader) Read(p []byte) (int, error) {
+	if c.pos >= len(c.data) {
+		return 0, io.EOF
+	}
+	n := c.size
+	if n > len(p) {
+		n = len(p)
+	}
+	if c.pos+n > len(c.data) {
+		n = len(c.data) - c.pos
+	}
+	copy(p, c.data[c.pos:c.pos+n])
+	c.pos += n
+	return n, nil
+}
+
+func TestGraphemeScannerAcrossBoundaries(t *testing.T) {
+	s := "👨‍👩‍👧👋🇺🇸é"
+	for size := 1; size <= 4; size++ {
+		got := scanAll(t, NewGraphemeScanner(&chunkedReader{data: s, size: size}))
+		var rebuilt strings.Builder
+		for _, g := range got {
+			rebuilt.WriteString(g)
+		}
+		if rebuilt.String() != s {
+			t.Errorf("chunk size %d: rebuilt %q, want %q", size, rebuilt.String(), s)
+		}
+		if len(got) != 4 {
+			t.Errorf("chunk size %d: got %d clusters %q, want 4", size, len(got), got)
+		}
+	}
+}
+
+func TestGraphemeReader(t *testing.T) {
+	r := NewGraphemeReader(strings.NewReader("Hi世界👋"))
+	var got []byte
+	buf := make([]byte, 8)
+	for {
+		n, err := r.Read(buf)
+		got = append(got, buf[:n]...)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Read error: %v", err)
+		}
+	}
+	if string(got) != "Hi世界👋" {
+		t.Errorf("got %q", got)
+	}
+}
@@ -0,0 +1,140 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import "strings"
+
+// Split slices s into substrings separated by sep, matching sep only at
+// grapheme-cluster boundaries. It behaves like strings.Split, except that
+// an occurrence of sep that would begin or end in the middle of a
+// grapheme cluster (for example inside a ZWJ sequence or a flag pair) is
+// not treated as a match.
+//
+// If sep is empty, Split splits after each grapheme cluster, matching
+// strings.Split's behavior of splitting after each UTF-8 sequence.
+func Split(s, sep string) []string {
+	return genSplit(s, sep, 0, -1)
+}
+
+// SplitN is like Split but stops after n substrings:
+//
+//	n > 0: at most n substrings; the last substring is the unsplit remainder.
+//	n == 0: nil (zero substrings).
+//	n < 0: all substrings, same as Split.
+func SplitN(s, sep string, n int) []string {
+	if n == 0 {
+		return nil
+	}
+	return genSplit(s, sep, 0, n)
+}
+
+// SplitAfter slices s into substrings after each instance of sep, matching
+// sep only at grapheme-cluster boundaries. Unlike Split, the separator
+// stays attached to the end of the preceding substring.
+func SplitAfter(s, sep string) []string {
+	return genSplit(s, sep, len(sep), -1)
+}
+
+// Join concatenates the elements of parts, placing sep between them.
+func Join(parts []string, sep string) string {
+	switch len(parts) {
+	case 0:
+		return ""
+	case 1:
+		return parts[0]
+	}
+	var b strings.Builder
+	b.Grow(len(sep)*(len(parts)-1) + len(strings.Join(parts, "")))
+	b.WriteString(parts[0])
+	for _, p := range parts[1:] {
+		b.WriteString(sep)
+		b.WriteString(p)
+	}
+	return b.String()
+}
+
+// genSplit mirrors the stdlib strings.genSplit algorithm, but a candidate
+// match of sep is only accepted when it begins and ends on a
+// grapheme-cluster boundary in s.
+func genSplit(s, sep string, sepSave, n int) []string {
+	if n == 0 {
+		return nil
+	}
+	if sep == "" {
+		return splitGraphemes(s, n)
+	}
+
+	offs := graphemeBoundaries(s)
+	atBoundary := make(map[int]bool, len(offs))
+	for _, o := range offs {
+		atBoundary[o] = true
+	}
+
+	var out []string
+	start := 0
+	for n < 0 || len(out) < n-1 {
+		i := nextBoundaryMatch(s, sep, start, atBoundary)
+		if i < 0 {
+			break
+		}
+		out = append(out, s[start:i+sepSave])
+		start = i + len(sep)
+	}
+	out = append(out, s[start:])
+	return out
+}
+
+// nextBoundaryMatch returns the byte offset of the next occurrence of sep
+// in s at or after start whose start and end both land on a
+// grapheme-cluster boundary, or -1 if there is none.
+func nextBoundaryMatch(s, sep string, start int, atBoundary map[int]bool) int {
+	for {
+		rel := strings.Index(s[start:], sep)
+		if rel < 0 {
+			return -1
+		}
+		idx := start + rel
+		if atBoundary[idx] && atBoundary[idx+len(sep)] {
+			return idx
+		}
+		start = idx + 1
+	}
+}
+
+// splitGraphemes splits s into its individual grapheme clusters, limited
+// to n elements with the final element holding the unsplit remainder.
+func splitGraphemes(s string, n int) []string {
+	offs := graphemeBoundaries(s)
+	total := len(offs) - 1
+	if n < 0 || n > total {
+		n = total
+	}
+	if n == 0 {
+		return nil
+	}
+	out := make([]string, n)
+	for i := 0; i < n-1; i++ {
+		out[i] = s[offs[i]:offs[i+1]]
+	}
+	out[n-1] = s[offs[n-1]:]
+	return out
+}
+
+// graphemeBoundaries returns the byte offsets of every grapheme-cluster
+// boundary in s, starting with 0 and ending with len(s).
+func graphemeBoundaries(s string) []int {
+	offs := make([]int, 1, Len(s)+1)
+	offs[0] = 0
+	pos := 0
+	it := Graphemes(s)
+	for {
+		g := it.Next()
+		if g == "" {
+			break
+		}
+		pos += len(g)
+		offs = append(offs, pos)
+	}
+	return offs
+}
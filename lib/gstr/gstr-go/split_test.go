@@ -0,0 +1,111 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import (
+	"reflect"
+	"testing"
+)
+
+var splitTests = []struct {
+	name string
+	s    string
+	sep  string
+	want []string
+}{
+	{"ascii commas", "a,b,c", ",", []string{"a", "b", "c"}},
+	{"ascii dots", "a.b.c", ".", []string{"a", "b", "c"}},
+	{"leading sep", ",a,b", ",", []string{"", "a", "b"}},
+	{"trailing sep", "a,b,", ",", []string{"a", "b", ""}},
+	{"no sep present", "abc", ",", []string{"abc"}},
+	{"empty sep splits graphemes", "abc", "", []string{"a", "b", "c"}},
+	{"empty string", "", ",", []string{""}},
+	{"faces", "😀|😁|😂", "|", []string{"😀", "😁", "😂"}},
+	{"zwj family separated", "👨‍👩‍👧|👋|🎉", "|", []string{"👨‍👩‍👧", "👋", "🎉"}},
+	{"flag separated", "🇺🇸|🇨🇦", "|", []string{"🇺🇸", "🇨🇦"}},
+	{"combining mark separated", "é|a", "|", []string{"é", "a"}},
+	{"sep inside zwj does not match", "a👨‍👩‍👧b", "‍", []string{"a👨‍👩‍👧b"}},
+}
+
+func TestSplit(t *testing.T) {
+	for _, tt := range splitTests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Split(tt.s, tt.sep)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Split(%q, %q) = %q, want %q", tt.s, tt.sep, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitN(t *testing.T) {
+	tests := []struct {
+		name string
+		s    string
+		sep  string
+		n    int
+		want []string
+	}{
+		{"n=0", "a,b,c", ",", 0, nil},
+		{"n=1", "a,b,c", ",", 1, []string{"a,b,c"}},
+		{"n=2", "a,b,c", ",", 2, []string{"a", "b,c"}},
+		{"n=-1 all", "a,b,c", ",", -1, []string{"a", "b", "c"}},
+		{"n larger than parts", "a,b", ",", 5, []string{"a", "b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SplitN(tt.s, tt.sep, tt.n)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SplitN(%q, %q, %d) = %q, want %q", tt.s, tt.sep, tt.n, got, tt.want)
+			}
+		})
+	}
+}
+
+var splitAfterTests = []struct {
+	name string
+	s    string
+	sep  string
+	want []string
+}{
+	{"ascii commas", "a,b,c", ",", []string{"a,", "b,", "c"}},
+	{"trailing sep", "a,b,", ",", []string{"a,", "b,", ""}},
+	{"faces", "😀|😁|😂", "|", []string{"😀|", "😁|", "😂"}},
+	{"zwj family", "👨‍👩‍👧|👋", "|", []string{"👨‍👩‍👧|", "👋"}},
+}
+
+func TestSplitAfter(t *testing.T) {
+	for _, tt := range splitAfterTests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SplitAfter(tt.s, tt.sep)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SplitAfter(%q, %q) = %q, want %q", tt.s, tt.sep, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJoin(t *testing.T) {
+	tests := []struct {
+		name  string
+		parts []string
+		sep   string
+		want  string
+	}{
+		{"empty", nil, ",", ""},
+		{"single", []string{"a"}, ",", "a"},
+		{"multi", []string{"a", "b", "c"}, ", ", "a, b, c"},
+		{"faces", []string{"😀", "😁"}, "|", "😀|😁"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Join(tt.parts, tt.sep)
+			if got != tt.want {
+				t.Errorf("Join(%q, %q) = %q, want %q", tt.parts, tt.sep, got, tt.want)
+			}
+		})
+	}
+}
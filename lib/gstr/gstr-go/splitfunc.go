@@ -0,0 +1,119 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"unicode/utf8"
+)
+
+// maxGraphemeBytes bounds how far ScanGraphemes will grow its look-ahead
+// window before forcing a cut, guarding against a pathological run of
+// ZWJs or combining marks consuming unbounded memory.
+const maxGraphemeBytes = 4096
+
+// ErrGraphemeTooLong is returned by ScanGraphemes when maxGraphemeBytes
+// or more bytes are buffered and no grapheme cluster can be read from
+// them. A cluster that merely runs past maxGraphemeBytes is not an
+// error: ScanGraphemes emits the first cluster found in the buffered
+// data, cutting a longer cluster at the end of what is buffered.
+var ErrGraphemeTooLong = errors.New("gstr: grapheme cluster exceeds maximum size")
+
+// ScanGraphemes is a bufio.SplitFunc that splits input into grapheme
+// clusters. Use it with bufio.Scanner directly, or via the Scanner type
+// below, which wires it up for you.
+func ScanGraphemes(data []byte, atEOF bool) (advance int, token []byte, err error) {
+	if atEOF && len(data) == 0 {
+		return 0, nil, nil
+	}
+	if tok, n, ok := nextSafeCluster(data, atEOF); ok {
+		return n, tok, nil
+	}
+	if atEOF {
+		return 0, nil, nil
+	}
+	if len(data) >= maxGraphemeBytes {
+		it := Graphemes(string(data))
+		if g := it.Next(); g != "" {
+			return len(g), []byte(g), nil
+		}
+		return 0, nil, ErrGraphemeTooLong
+	}
+	return 0, nil, nil // request more data
+}
+
+// Scanner reads grapheme clusters from an io.Reader using bufio.Scanner
+// and ScanGraphemes underneath. Prefer Scanner when you want bufio's
+// buffering and token-size controls with a familiar Scan/Err loop; use
+// GraphemeScanner instead when you want the scanner to manage its own
+// buffer growth without bufio.
+type Scanner struct {
+	sc     *bufio.Scanner
+	strict bool
+	cur    string
+	err    error
+}
+
+// NewScanner returns a Scanner that reads grapheme clusters from r. By
+// default, invalid UTF-8 in the stream is surfaced as a single
+// U+FFFD replacement-character grapheme; pass WithStrictUTF8 to instead
+// stop scanning and report an error.
+func NewScanner(r io.Reader, opts ...func(*Scanner)) *Scanner {
+	sc := bufio.NewScanner(r)
+	sc.Split(ScanGraphemes)
+	s := &Scanner{sc: sc}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// WithStrictUTF8 configures a Scanner to stop and report an error on
+// invalid UTF-8 instead of substituting U+FFFD.
+func WithStrictUTF8(s *Scanner) {
+	s.strict = true
+}
+
+// Buffer sets the initial buffer to use for scanning and the maximum
+// size it may grow to, as with bufio.Scanner.Buffer.
+func (s *Scanner) Buffer(buf []byte, max int) {
+	s.sc.Buffer(buf, max)
+}
+
+// Scan advances the Scanner to the next grapheme cluster, which will
+// then be available through Grapheme. It returns false when scanning
+// stops, either by reaching the end of the input or an error.
+func (s *Scanner) Scan() bool {
+	if s.err != nil {
+		return false
+	}
+	if !s.sc.Scan() {
+		return false
+	}
+	tok := s.sc.Text()
+	if !Valid(tok) {
+		if s.strict {
+			s.err = errors.New("gstr: invalid UTF-8 in stream")
+			return false
+		}
+		tok = string(utf8.RuneError)
+	}
+	s.cur = tok
+	return true
+}
+
+// Grapheme returns the most recent grapheme cluster generated by Scan.
+func (s *Scanner) Grapheme() string {
+	return s.cur
+}
+
+// Err returns the first non-EOF error encountered while scanning.
+func (s *Scanner) Err() error {
+	if s.err != nil {
+		return s.err
+	}
+	return s.sc.Err()
+}
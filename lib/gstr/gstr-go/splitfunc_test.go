@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import (
+	"bufio"
+	"strings"
+	"testing"
+)
+
+func TestScanGraphemesWithBufioScanner(t *testing.T) {
+	sc := bufio.NewScanner(strings.NewReader("Hi世界👋"))
+	sc.Split(ScanGraphemes)
+
+	var got []string
+	for sc.Scan() {
+		got = append(got, sc.Text())
+	}
+	if err := sc.Err(); err != nil {
+		t.Fatalf("scan error: %v", err)
+	}
+	want := []string{"H", "i", "世", "界", "👋"}
+	if len(got) != len(want) {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
+
+func TestScanner(t *testing.T) {
+	sc := NewScanner(strings.NewReader("👨‍👩‍👧👋"))
+	var got []string
+	for sc.Scan() {
+		got = append(got, sc.Grapheme())
+	}
+	if err := sc.Err(); err != nil {
+		t.Fatalf("scan error: %v", err)
+	}
+	if len(got) != 2 {
+		t.Fatalf("got %d clusters %q, want 2", len(got), got)
+	}
+}
+
+func TestScannerInvalidUTF8(t *testing.T) {
+	bad := string([]byte{0xFF, 0xFE})
+	sc := NewScanner(strings.NewReader(bad))
+	if !sc.Scan() {
+		t.Fatalf("Scan() = false, want true with replacement char: %v", sc.Err())
+	}
+}
+
+func TestScannerStrictUTF8(t *testing.T) {
+	bad := string([]byte{0xFF, 0xFE})
+	sc := NewScanner(strings.NewReader(bad), WithStrictUTF8)
+	for sc.Scan() {
+	}
+	if sc.Err() == nil {
+		t.Error("expected error for invalid UTF-8 in strict mode")
+	}
+}
@@ -0,0 +1,86 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import "strings"
+
+// SplitIterator yields the substrings Split(s, sep) would return, one at
+// a time, without allocating the full result slice up front.
+type SplitIterator struct {
+	s         string
+	sep       string
+	start     int // start of the field Next will return
+	search    int // where to resume scanning for the next separator candidate
+	done      bool
+	graphemes *GraphemeIterator // used when sep == ""
+	scan      *GraphemeIterator // incremental boundary scan when sep != ""
+	scanPos   int               // furthest byte offset scan has confirmed is a boundary
+}
+
+// SplitIter returns a SplitIterator over the substrings of s that
+// Split(s, sep) would return, matching sep only at grapheme-cluster
+// boundaries. It's useful when only the first few fields of a long
+// string are needed: unlike Split, it never scans past the separator
+// match it is about to return - each Next() call discovers the next
+// boundary incrementally instead of walking all of s up front.
+func SplitIter(s, sep string) *SplitIterator {
+	if sep == "" {
+		return &SplitIterator{s: s, sep: sep, graphemes: Graphemes(s)}
+	}
+	return &SplitIterator{s: s, sep: sep, scan: Graphemes(s)}
+}
+
+// isBoundary reports whether target is a grapheme-cluster boundary,
+// advancing the incremental scan forward as needed. Callers only ever
+// query non-decreasing targets (Next never looks earlier in s than its
+// last match), so the scan never has to restart from the beginning.
+func (it *SplitIterator) isBoundary(target int) bool {
+	for it.scanPos < target {
+		g := it.scan.Next()
+		if g == "" {
+			break
+		}
+		it.scanPos += len(g)
+	}
+	return it.scanPos == target
+}
+
+// Next returns the next substring and true, or ("", false) once every
+// substring Split would have produced has already been returned.
+func (it *SplitIterator) Next() (string, bool) {
+	if it.done {
+		return "", false
+	}
+	if it.graphemes != nil {
+		g := it.graphemes.Next()
+		if g == "" {
+			it.done = true
+			return "", false
+		}
+		return g, true
+	}
+	for {
+		rel := strings.Index(it.s[it.search:], it.sep)
+		if rel < 0 {
+			out := it.s[it.start:]
+			it.done = true
+			return out, true
+		}
+		idx := it.search + rel
+		if it.isBoundary(idx) && it.isBoundary(idx+len(it.sep)) {
+			out := it.s[it.start:idx]
+			it.start = idx + len(it.sep)
+			it.search = it.start
+			return out, true
+		}
+		// idx didn't land on a grapheme boundary at one or both ends, so
+		// it can't be a real separator match (e.g. it's inside a ZWJ
+		// sequence). isBoundary has already advanced scanPos to the next
+		// confirmed boundary past idx; resume the search for the next
+		// candidate there, not at idx+1, so we never re-enter the middle
+		// of a multi-byte cluster - and keep it.start where it is, since
+		// the pending field hasn't found its separator yet.
+		it.search = it.scanPos
+	}
+}
@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import (
+	"reflect"
+	"testing"
+)
+
+func drainSplitIter(it *SplitIterator) []string {
+	var out []string
+	for {
+		s, ok := it.Next()
+		if !ok {
+			return out
+		}
+		out = append(out, s)
+	}
+}
+
+func TestSplitIterMatchesSplit(t *testing.T) {
+	for _, tt := range splitTests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := drainSplitIter(SplitIter(tt.s, tt.sep))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SplitIter(%q, %q) = %q, want %q", tt.s, tt.sep, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSplitIterExhausted(t *testing.T) {
+	it := SplitIter("a,b", ",")
+	drainSplitIter(it)
+	if s, ok := it.Next(); ok {
+		t.Errorf("Next() after exhaustion = (%q, true), want (\"\", false)", s)
+	}
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import (
+	"reflect"
+	"testing"
+)
+
+// These cover the CJK/emoji separator cases called out alongside Fields,
+// Split, SplitN, and Cut: a splitter must match at grapheme-cluster
+// boundaries so it never slices through a ZWJ sequence.
+
+func TestSplitCJKSeparator(t *testing.T) {
+	got := Split("a世b世c", "世")
+	want := []string{"a", "b", "c"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Split with CJK separator = %q, want %q", got, want)
+	}
+}
+
+func TestSplitNCJKSeparator(t *testing.T) {
+	got := SplitN("a世b世c", "世", 2)
+	want := []string{"a", "b世c"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SplitN with CJK separator = %q, want %q", got, want)
+	}
+}
+
+func TestCutEmojiSeparator(t *testing.T) {
+	before, after, found := Cut("left👋right", "👋")
+	if !found || before != "left" || after != "right" {
+		t.Errorf("Cut with emoji separator = (%q, %q, %v)", before, after, found)
+	}
+}
+
+func TestFieldsFuncPerCluster(t *testing.T) {
+	calls := 0
+	FieldsFunc("👨‍👩‍👧 x", func(g string) bool {
+		calls++
+		return isSpaceGrapheme(g)
+	})
+	// Exactly three clusters: the family emoji, the space, and "x".
+	if calls != 3 {
+		t.Errorf("FieldsFunc invoked predicate %d times, want 3", calls)
+	}
+}
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import "strings"
+
+// Wrap breaks s into lines of at most width display columns, never
+// splitting inside a grapheme cluster (so a ZWJ sequence or a base
+// character plus its combining mark always stay on the same line). A
+// single cluster wider than width is placed alone on its own line.
+func Wrap(s string, width int) []string {
+	var lines []string
+	var cur []string
+	curWidth := 0
+	it := Graphemes(s)
+	for {
+		g := it.Next()
+		if g == "" {
+			break
+		}
+		w := Width(g)
+		if w > width {
+			if len(cur) > 0 {
+				lines = append(lines, strings.Join(cur, ""))
+				cur, curWidth = nil, 0
+			}
+			lines = append(lines, g)
+			continue
+		}
+		if curWidth+w > width && len(cur) > 0 {
+			lines = append(lines, strings.Join(cur, ""))
+			cur, curWidth = nil, 0
+		}
+		cur = append(cur, g)
+		curWidth += w
+	}
+	if len(cur) > 0 {
+		lines = append(lines, strings.Join(cur, ""))
+	}
+	return lines
+}
+
+// WordWrap wraps s to width display columns, joined back into a single
+// string with newlines. It prefers breaking at word boundaries
+// (whitespace); a run of text with no break opportunity (such as CJK
+// prose, where any boundary is legal) or a single word wider than width
+// falls back to Wrap's hard per-cluster break.
+func WordWrap(s string, width int) string {
+	words := Fields(s)
+	var lines []string
+	var cur strings.Builder
+	curWidth := 0
+
+	flush := func() {
+		if cur.Len() > 0 {
+			lines = append(lines, cur.String())
+			cur.Reset()
+			curWidth = 0
+		}
+	}
+
+	for _, w := range words {
+		ww := Width(w)
+		if ww > width {
+			flush()
+			lines = append(lines, Wrap(w, width)...)
+			continue
+		}
+		switch {
+		case curWidth == 0:
+			cur.WriteString(w)
+			curWidth = ww
+		case curWidth+1+ww <= width:
+			cur.WriteString(" ")
+			cur.WriteString(w)
+			curWidth += 1 + ww
+		default:
+			flush()
+			cur.WriteString(w)
+			curWidth = ww
+		}
+	}
+	flush()
+	return strings.Join(lines, "\n")
+}
+
+// BoxStyle is a set of Unicode box-drawing glyphs used by Box.
+type BoxStyle struct {
+	TopLeft, TopRight       string
+	BottomLeft, BottomRight string
+	Horizontal, Vertical    string
+}
+
+var (
+	// BoxStyleLight draws a single-line box: ┌─┐ / │ │ / └─┘.
+	BoxStyleLight = BoxStyle{"┌", "┐", "└", "┘", "─", "│"}
+	// BoxStyleDouble draws a double-line box: ╔═╗ / ║ ║ / ╚═╝.
+	BoxStyleDouble = BoxStyle{"╔", "╗", "╚", "╝", "═", "║"}
+	// BoxStyleRounded draws a single-line box with rounded corners.
+	BoxStyleRounded = BoxStyle{"╭", "╮", "╰", "╯", "─", "│"}
+)
+
+// Box word-wraps s to width display columns and draws a Unicode box
+// around the result using style.
+func Box(s string, width int, style BoxStyle) string {
+	var lines []string
+	if wrapped := WordWrap(s, width); wrapped != "" {
+		lines = strings.Split(wrapped, "\n")
+	}
+	var b strings.Builder
+	b.WriteString(style.TopLeft)
+	b.WriteString(strings.Repeat(style.Horizontal, width+2))
+	b.WriteString(style.TopRight)
+	b.WriteString("\n")
+	for _, line := range lines {
+		b.WriteString(style.Vertical)
+		b.WriteString(" ")
+		b.WriteString(line)
+		if pad := width - Width(line); pad > 0 {
+			b.WriteString(strings.Repeat(" ", pad))
+		}
+		b.WriteString(" ")
+		b.WriteString(style.Vertical)
+		b.WriteString("\n")
+	}
+	b.WriteString(style.BottomLeft)
+	b.WriteString(strings.Repeat(style.Horizontal, width+2))
+	b.WriteString(style.BottomRight)
+	return b.String()
+}
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: MIT
+// Copyright (c) 2025 Edward J Edmonds
+
+package gstr
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestWrap(t *testing.T) {
+	lines := Wrap("abcdef", 3)
+	want := []string{"abc", "def"}
+	if len(lines) != len(want) {
+		t.Fatalf("Wrap = %q, want %q", lines, want)
+	}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Errorf("line %d = %q, want %q", i, lines[i], want[i])
+		}
+	}
+}
+
+func TestWrapDoesNotSplitZWJ(t *testing.T) {
+	lines := Wrap("a👨‍👩‍👧b", 1)
+	for _, l := range lines {
+		if !Valid(l) {
+			t.Errorf("invalid cluster in wrapped line %q", l)
+		}
+	}
+	joined := strings.Join(lines, "")
+	if joined != "a👨‍👩‍👧b" {
+		t.Errorf("rejoining wrapped lines = %q, want original", joined)
+	}
+}
+
+func TestWrapHardBreakWideCluster(t *testing.T) {
+	lines := Wrap("👋", 1)
+	if len(lines) != 1 || lines[0] != "👋" {
+		t.Errorf("Wrap(👋, 1) = %q, want single line with the wide cluster alone", lines)
+	}
+}
+
+func TestWordWrap(t *testing.T) {
+	got := WordWrap("the quick brown fox", 10)
+	want := "the quick\nbrown fox"
+	if got != want {
+		t.Errorf("WordWrap = %q, want %q", got, want)
+	}
+}
+
+func TestWordWrapOversizedWord(t *testing.T) {
+	got := WordWrap("supercalifragilisticexpialidocious", 5)
+	if !strings.Contains(got, "\n") {
+		t.Error("expected oversized word to be hard-broken across lines")
+	}
+}
+
+func TestBox(t *testing.T) {
+	got := Box("hi", 10, BoxStyleLight)
+	lines := strings.Split(got, "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Box produced %d lines, want 3", len(lines))
+	}
+	if !strings.HasPrefix(lines[0], "┌") || !strings.HasSuffix(lines[0], "┐") {
+		t.Errorf("top border = %q", lines[0])
+	}
+	if !strings.HasPrefix(lines[2], "└") || !strings.HasSuffix(lines[2], "┘") {
+		t.Errorf("bottom border = %q", lines[2])
+	}
+}
+
+func TestBoxBreaksAtWordsNotMidWord(t *testing.T) {
+	got := Box("the quick brown fox", 10, BoxStyleLight)
+	lines := strings.Split(got, "\n")
+	if len(lines) != 4 {
+		t.Fatalf("Box produced %d lines, want 4 (top, 2 content, bottom); got %q", len(lines), got)
+	}
+	if !strings.Contains(lines[1], "the quick") {
+		t.Errorf("first content row = %q, want it to contain the whole word %q", lines[1], "quick")
+	}
+	if !strings.Contains(lines[2], "brown fox") {
+		t.Errorf("second content row = %q, want it to contain the whole word %q", lines[2], "brown")
+	}
+}